@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/almahoozi/wlog/internal/app"
@@ -16,7 +15,6 @@ var (
 func main() {
 	info := app.BuildInfo{Commit: commit, Ref: ref, Version: version}
 	if err := app.Run(os.Args[1:], info); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(app.ReportError(err))
 	}
 }
@@ -4,12 +4,20 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/almahoozi/wlog/internal/app"
 	"github.com/almahoozi/wlog/internal/tuiapp"
 )
 
 func main() {
-	if err := tuiapp.Run(); err != nil {
+	globals, _, err := app.ParseGlobalFlags(os.Args[1:])
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	tuiapp.SetEditor(globals.Editor)
+	app.SetQuiet(globals.Quiet)
+	app.SetConfigPath(globals.Config)
+	if err := tuiapp.Run(); err != nil {
+		os.Exit(app.ReportError(err))
+	}
 }
@@ -0,0 +1,320 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type addOptions struct {
+	Selector     string
+	QuestionText string
+	Text         string
+	Split        bool
+	Priority     string
+	Date         *time.Time
+	Clock        *int
+}
+
+func parseAddArgs(args []string) (addOptions, error) {
+	var opts addOptions
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, _, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--split":
+			opts.Split = true
+		case "--priority":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			priority, err := parsePriority(value)
+			if err != nil {
+				return opts, err
+			}
+			opts.Priority = priority
+		case "--question-text":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			opts.QuestionText = strings.TrimSpace(value)
+			if opts.QuestionText == "" {
+				return opts, fmt.Errorf("--question-text must not be empty")
+			}
+		case "--date":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			day, err := parseAddDate(value)
+			if err != nil {
+				return opts, err
+			}
+			opts.Date = &day
+		case "--time":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			minutes, err := parseClock(value)
+			if err != nil {
+				return opts, fmt.Errorf("invalid --time %q, expected HH:MM", value)
+			}
+			opts.Clock = &minutes
+		default:
+			if strings.HasPrefix(arg, "--") {
+				return opts, fmt.Errorf("unknown flag %q", arg)
+			}
+			positional = append(positional, arg)
+		}
+	}
+	if opts.QuestionText != "" {
+		// The question is given verbatim, so every positional is entry text.
+		opts.Text = strings.TrimSpace(strings.Join(positional, " "))
+		return opts, nil
+	}
+	if len(positional) == 0 {
+		return opts, fmt.Errorf("missing question selector, run `wlog questions` to list them")
+	}
+	opts.Selector = positional[0]
+	opts.Text = strings.TrimSpace(strings.Join(positional[1:], " "))
+	return opts, nil
+}
+
+func RunAdd(args []string, cfg Config, dryRun bool) error {
+	opts, err := parseAddArgs(args)
+	if err != nil {
+		return err
+	}
+	var question string
+	switch {
+	case opts.QuestionText != "":
+		question = opts.QuestionText
+	case opts.Text == "" && stdinIsTerminal():
+		// Nothing can be read from a terminal stdin, so a lone argument is the
+		// entry text and the question is picked interactively.
+		opts.Text = opts.Selector
+		question, err = pickQuestion(cfg.Questions, os.Stdin, os.Stdout)
+	default:
+		question, err = resolveQuestion(opts.Selector, cfg.Questions)
+	}
+	if err != nil {
+		return err
+	}
+
+	responses, err := addResponses(opts)
+	if err != nil {
+		return err
+	}
+	if len(responses) == 0 {
+		return fmt.Errorf("nothing to add, pass the entry text or pipe it on stdin")
+	}
+
+	settings := cfg.Settings()
+	day, timestamp := opts.entryTime(time.Now(), settings)
+	if err := cfg.CheckEntryDay(day); err != nil {
+		return err
+	}
+	log, err := LoadDayLog(day)
+	if err != nil {
+		return err
+	}
+
+	var added []plannedAnswer
+	for _, response := range responses {
+		if cfg.DedupeEntriesEnabled() && HasResponse(log.Answers[question], response) {
+			fmt.Printf("Duplicate skipped: %s\n", response)
+			continue
+		}
+		ans := Answer{Time: timestamp, Response: response, Priority: opts.Priority}
+		log.Answers[question] = insertByTime(log.Answers[question], ans)
+		added = append(added, plannedAnswer{Question: question, Answer: ans})
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	if dryRun {
+		path, err := DayFilePath(day)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, added, settings)
+		return nil
+	}
+	if err := SaveDayLog(day, log, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Saved %d %s to %q on %s.\n", len(added), pluralize(len(added), "entry", "entries"), question, day.Format("2006-01-02"))
+	return nil
+}
+
+// parseAddDate accepts a YYYY-MM-DD date or a single-day interval such as
+// "yesterday".
+func parseAddDate(value string) (time.Time, error) {
+	if day, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(value), time.Local); err == nil {
+		return day, nil
+	}
+	start, end, err := ParseInterval(value)
+	if err != nil || !start.Equal(end) {
+		return time.Time{}, fmt.Errorf("invalid --date %q, expected YYYY-MM-DD or a single day like \"yesterday\"", value)
+	}
+	return start, nil
+}
+
+// entryTime returns the day file and RFC3339 timestamp for new entries:
+// --date and --time replace the date and clock of now respectively.
+func (opts addOptions) entryTime(now time.Time, settings Settings) (time.Time, string) {
+	day := DayFloor(now)
+	if opts.Date != nil {
+		day = *opts.Date
+	}
+	hour, minute, second := now.Clock()
+	if opts.Clock != nil {
+		hour, minute, second = *opts.Clock/60, *opts.Clock%60, 0
+	}
+	at := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.Local)
+	return day, settings.EntryTimestamp(at)
+}
+
+// insertByTime adds ans after every answer logged at or before its time, so
+// backfilled entries land in chronological position.
+func insertByTime(answers []Answer, ans Answer) []Answer {
+	at := answerTime(ans)
+	idx := len(answers)
+	for idx > 0 && answerTime(answers[idx-1]).After(at) {
+		idx--
+	}
+	answers = append(answers, Answer{})
+	copy(answers[idx+1:], answers[idx:])
+	answers[idx] = ans
+	return answers
+}
+
+// addResponses returns the entry text from the arguments, or from stdin when
+// no text was given and stdin is not a terminal. With --split every non-empty
+// line becomes its own entry.
+func addResponses(opts addOptions) ([]string, error) {
+	text := opts.Text
+	if text == "" {
+		if stdinIsTerminal() {
+			return nil, nil
+		}
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	}
+	if !opts.Split {
+		if trimmed := strings.TrimSpace(text); trimmed != "" {
+			return []string{trimmed}, nil
+		}
+		return nil, nil
+	}
+	return parseResponseLines(text), nil
+}
+
+func parseResponseLines(text string) []string {
+	var responses []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			responses = append(responses, trimmed)
+		}
+	}
+	return responses
+}
+
+// pickQuestion lists questions with their `wlog questions` index and reads a
+// choice from in, asking again until it gets a valid number.
+func pickQuestion(questions []string, in io.Reader, out io.Writer) (string, error) {
+	if len(questions) == 0 {
+		return "", errors.New("no questions configured")
+	}
+	for i, q := range questions {
+		fmt.Fprintf(out, "%2d. %s\n", i+1, q)
+	}
+	reader := bufio.NewReader(in)
+	for {
+		fmt.Fprintf(out, "Question [1-%d]: ", len(questions))
+		line, err := reader.ReadString('\n')
+		choice := strings.TrimSpace(line)
+		if n, convErr := strconv.Atoi(choice); convErr == nil && n >= 1 && n <= len(questions) {
+			return questions[n-1], nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", errors.New("no question selected")
+			}
+			return "", err
+		}
+		if choice != "" {
+			fmt.Fprintf(out, "Enter a number from 1 to %d.\n", len(questions))
+		}
+	}
+}
+
+// stdinIsTerminal reports whether stdin is an interactive terminal; tests
+// replace it to reach the prompting paths.
+var stdinIsTerminal = func() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// resolveQuestion maps a selector to a configured question. Numbers are
+// 1-based indices as printed by `wlog questions`, a single letter is the TUI
+// label, and anything else must match exactly one question by
+// case-insensitive substring.
+func resolveQuestion(selector string, questions []string) (string, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return "", errors.New("empty question selector")
+	}
+	if n, err := strconv.Atoi(selector); err == nil {
+		if n < 1 || n > len(questions) {
+			return "", fmt.Errorf("question index %d out of range (1-%d)", n, len(questions))
+		}
+		return questions[n-1], nil
+	}
+	if runes := []rune(strings.ToLower(selector)); len(runes) == 1 {
+		for idx, label := range listIndexRunes {
+			if label != runes[0] {
+				continue
+			}
+			if idx >= len(questions) {
+				return "", fmt.Errorf("no question labeled %q", selector)
+			}
+			return questions[idx], nil
+		}
+	}
+
+	needle := strings.ToLower(selector)
+	var matches []string
+	for _, q := range questions {
+		if strings.EqualFold(q, selector) {
+			return q, nil
+		}
+		if strings.Contains(strings.ToLower(q), needle) {
+			matches = append(matches, q)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no question matches %q", selector)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%q matches %d questions, be more specific", selector, len(matches))
+	}
+}
@@ -0,0 +1,351 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResolveQuestion(t *testing.T) {
+	questions := []string{"What did you do?", "What is next?", "Blockers?"}
+	tests := []struct {
+		selector string
+		want     string
+		err      string
+	}{
+		{"1", "What did you do?", ""},
+		{"2", "What is next?", ""},
+		{" 3 ", "Blockers?", ""},
+		{"0", "", "out of range (1-3)"},
+		{"4", "", "out of range (1-3)"},
+		{"-1", "", "out of range (1-3)"},
+		{"a", "", "no question labeled"},
+		{"blockers?", "Blockers?", ""},
+		{"next", "What is next?", ""},
+		{"What", "", "matches 2 questions"},
+		{"lunch", "", "no question matches"},
+		{"", "", "empty question selector"},
+	}
+	for _, tt := range tests {
+		got, err := resolveQuestion(tt.selector, questions)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("resolveQuestion(%q) error = %v, want %q", tt.selector, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("resolveQuestion(%q) = %q, %v; want %q", tt.selector, got, err, tt.want)
+		}
+	}
+}
+
+func TestResolveQuestionMatchesListedIndexes(t *testing.T) {
+	questions := make([]string, 12)
+	for i := range questions {
+		questions[i] = fmt.Sprintf("Question %02d?", i)
+	}
+	out := captureStdout(t, func() { RunQuestions(questions) })
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var index int
+		var label, question string
+		fields := strings.Fields(line)
+		if _, err := fmt.Sscan(fields[0], &index); err != nil {
+			t.Fatalf("no index in %q", line)
+		}
+		label = strings.Trim(fields[1], "[]")
+		question = strings.Join(fields[2:], " ")
+		// Digit labels read as 1-based numbers, so only letters select by label.
+		selectors := []string{fmt.Sprint(index)}
+		if _, err := strconv.Atoi(label); err != nil {
+			selectors = append(selectors, label)
+		}
+		for _, selector := range selectors {
+			if got, err := resolveQuestion(selector, questions); err != nil || got != question {
+				t.Errorf("selector %q from %q resolved to %q, %v", selector, line, got, err)
+			}
+		}
+	}
+	if got, _ := resolveQuestion("a", questions); got != "Question 10?" {
+		t.Errorf("label a resolved to %q, want Question 10?", got)
+	}
+}
+
+func TestPickQuestionUsesListedIndexes(t *testing.T) {
+	questions := []string{"First?", "Second?", "Third?"}
+	var out strings.Builder
+	got, err := pickQuestion(questions, strings.NewReader("0\nnope\n1\n"), &out)
+	if err != nil || got != "First?" {
+		t.Fatalf("pickQuestion = %q, %v", got, err)
+	}
+	for _, want := range []string{" 1. First?", " 3. Third?", "Question [1-3]: ", "Enter a number from 1 to 3."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+	if _, err := pickQuestion(questions, strings.NewReader(""), &out); err == nil {
+		t.Error("expected an error when input ends without a choice")
+	}
+}
+
+func TestQuestionIndexesAcrossCommands(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"First?", "Second?"}}
+	captureStdout(t, func() {
+		if err := RunAdd([]string{"1", "added"}, cfg, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := RunSet([]string{"--set", "2=set"}, cfg, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers["First?"]) != 1 || len(log.Answers["Second?"]) != 1 {
+		t.Fatalf("answers = %+v", log.Answers)
+	}
+	captureStdout(t, func() {
+		if err := RunRemind([]string{"--require", "1", "--require=2"}, cfg); err != nil {
+			t.Fatalf("remind with both questions answered: %v", err)
+		}
+		if err := RunRemind([]string{"--require", "3"}, cfg); err == nil || errors.Is(err, ErrNoEntries) {
+			t.Fatalf("remind --require 3 error = %v, want out of range", err)
+		}
+	})
+}
+
+func TestAddDedupeEntries(t *testing.T) {
+	for _, dedupe := range []bool{true, false} {
+		useTempDirs(t)
+		cfg := Config{Questions: []string{"Q"}, DedupeEntries: boolPtr(dedupe)}
+		var out string
+		for _, text := range []string{"same", "  same "} {
+			out = captureStdout(t, func() {
+				if err := RunAdd([]string{"1", text}, cfg, false); err != nil {
+					t.Fatal(err)
+				}
+			})
+		}
+		log, err := LoadDayLog(DayFloor(time.Now()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := 2
+		if dedupe {
+			want = 1
+		}
+		if got := len(log.Answers["Q"]); got != want {
+			t.Errorf("dedupeEntries=%v: %d answers, want %d", dedupe, got, want)
+		}
+		if skipped := strings.Contains(out, "Duplicate skipped"); skipped != dedupe {
+			t.Errorf("dedupeEntries=%v: output %q", dedupe, out)
+		}
+	}
+}
+
+func TestAddReadsStdin(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		input string
+		want  []string
+	}{
+		{"single line", []string{"1"}, "did X\n", []string{"did X"}},
+		{"multi-line is one answer", []string{"1"}, "line one\nline two\n", []string{"line one\nline two"}},
+		{"split", []string{"--split", "1"}, "line one\r\n\n  line two  \n", []string{"line one", "line two"}},
+		{"argument wins", []string{"1", "from args"}, "from stdin\n", []string{"from args"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			cfg := Config{Questions: []string{"Q"}}
+			withStdin(t, tt.input, func() {
+				captureStdout(t, func() {
+					if err := RunAdd(tt.args, cfg, false); err != nil {
+						t.Fatal(err)
+					}
+				})
+			})
+			log, err := LoadDayLog(DayFloor(time.Now()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, ans := range log.Answers["Q"] {
+				got = append(got, ans.Response)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("answers = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	useTempDirs(t)
+	withStdin(t, "  \n", func() {
+		if err := RunAdd([]string{"1"}, Config{Questions: []string{"Q"}}, false); err == nil {
+			t.Fatal("blank stdin added an entry")
+		}
+	})
+}
+
+func TestAddEntryTime(t *testing.T) {
+	now := time.Date(2026, 3, 5, 16, 20, 45, 0, time.Local)
+	settings := Settings{}
+	tests := []struct {
+		args []string
+		day  string
+		at   time.Time
+	}{
+		{[]string{"1", "x"}, "2026-03-05", now},
+		{[]string{"--time", "09:05", "1", "x"}, "2026-03-05", time.Date(2026, 3, 5, 9, 5, 0, 0, time.Local)},
+		{[]string{"--date", "2026-03-02", "1", "x"}, "2026-03-02", time.Date(2026, 3, 2, 16, 20, 45, 0, time.Local)},
+		{[]string{"--date", "2026-03-02", "--time", "23:59", "1", "x"}, "2026-03-02", time.Date(2026, 3, 2, 23, 59, 0, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		opts, err := parseAddArgs(tt.args)
+		if err != nil {
+			t.Fatalf("parseAddArgs(%q): %v", tt.args, err)
+		}
+		day, stamp := opts.entryTime(now, settings)
+		if got := day.Format("2006-01-02"); got != tt.day {
+			t.Errorf("%q: day = %s, want %s", tt.args, got, tt.day)
+		}
+		if want := tt.at.Format(time.RFC3339); stamp != want {
+			t.Errorf("%q: timestamp = %s, want %s", tt.args, stamp, want)
+		}
+	}
+	for _, value := range []string{"25:00", "9am", "12:60", ""} {
+		if _, err := parseAddArgs([]string{"--time", value, "1", "x"}); err == nil {
+			t.Errorf("--time %q accepted", value)
+		}
+	}
+}
+
+func TestAddTimeSortsBackfilledEntries(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Q"}}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+	for _, clock := range []string{"11:00", "09:00", "10:00"} {
+		if _, err := runOutput(t, "add", "--date", "2026-03-02", "--time", clock, "1", "at "+clock); err != nil {
+			t.Fatal(err)
+		}
+	}
+	log, err := LoadDayLog(mustDay(t, "2026-03-02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ans := range log.Answers["Q"] {
+		got = append(got, ans.Response)
+	}
+	if want := []string{"at 09:00", "at 10:00", "at 11:00"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("stored order = %v, want %v", got, want)
+	}
+	out, err := runOutput(t, "cat", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, b, c := strings.Index(out, "[09:00] at 09:00"), strings.Index(out, "[10:00] at 10:00"), strings.Index(out, "[11:00] at 11:00"); a < 0 || a > b || b > c {
+		t.Fatalf("cat not in time order:\n%s", out)
+	}
+}
+
+func TestAddPicksQuestionOnTerminal(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"First?", "Second?"}}
+	defer func(orig func() bool) { stdinIsTerminal = orig }(stdinIsTerminal)
+
+	stdinIsTerminal = func() bool { return true }
+	var out string
+	withStdin(t, "2\n", func() {
+		out = captureStdout(t, func() {
+			if err := RunAdd([]string{"did X"}, cfg, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	if !strings.Contains(out, " 2. Second?") || !strings.Contains(out, `Saved 1 entry to "Second?"`) {
+		t.Fatalf("picker output:\n%s", out)
+	}
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := log.Answers["Second?"]; len(got) != 1 || got[0].Response != "did X" {
+		t.Fatalf("answers = %+v", log.Answers)
+	}
+
+	stdinIsTerminal = func() bool { return false }
+	withStdin(t, "2\n", func() {
+		captureStdout(t, func() {
+			if err := RunAdd([]string{"did Y"}, cfg, false); err == nil {
+				t.Fatal("non-terminal stdin fell back to the picker instead of erroring")
+			}
+		})
+	})
+}
+
+func TestAddQuestionText(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Done?"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runOutput(t, "add", "--question-text", "  Side project?  ", "0", "wrote docs"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runOutput(t, "add", "1", "shipped"); err != nil {
+		t.Fatal(err)
+	}
+	data := readDayFile(t, DayFloor(time.Now()))
+	if !strings.Contains(data, `"Side project?"`) || !strings.Contains(data, `"response": "0 wrote docs"`) {
+		t.Fatalf("ad-hoc question not saved under its text:\n%s", data)
+	}
+
+	out, err := runOutput(t, "view")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, side := strings.Index(out, "Done?"), strings.Index(out, "Side project?")
+	if done < 0 || side < done || !strings.Contains(out, "0 wrote docs") {
+		t.Fatalf("ad-hoc question not listed as an extra after the configured ones:\n%s", out)
+	}
+
+	for _, value := range []string{"", "   "} {
+		if _, err := runOutput(t, "add", "--question-text", value, "x"); err == nil {
+			t.Errorf("--question-text %q accepted", value)
+		}
+	}
+}
+
+func TestAddRefusesFutureDay(t *testing.T) {
+	useTempDirs(t)
+	tomorrow := DayFloor(time.Now()).AddDate(0, 0, 1)
+	date := tomorrow.Format("2006-01-02")
+	cfg := Config{Questions: []string{"Q"}}
+	err := RunAdd([]string{"--date", date, "1", "planned"}, cfg, false)
+	if err == nil || !strings.Contains(err.Error(), "allowFutureEntries") {
+		t.Fatalf("future add: err = %v, want a refusal naming allowFutureEntries", err)
+	}
+	if log, _ := ReadDayLogIfExists(tomorrow); log != nil {
+		t.Fatal("refused add wrote a day file")
+	}
+
+	cfg.AllowFutureEntries = boolPtr(true)
+	captureStdout(t, func() {
+		if err := RunAdd([]string{"--date", date, "1", "planned"}, cfg, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	log, err := LoadDayLog(tomorrow)
+	if err != nil || len(log.Answers["Q"]) != 1 {
+		t.Fatalf("allowed future add: %+v, %v", log, err)
+	}
+}
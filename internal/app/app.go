@@ -2,11 +2,13 @@ package app
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/fs"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -15,13 +17,15 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
+	"unicode/utf8"
 )
 
 type BuildInfo struct {
-	Commit  string
-	Ref     string
-	Version string
+	Commit  string `json:"commit"`
+	Ref     string `json:"ref"`
+	Version string `json:"version"`
 }
 
 var DefaultQuestions = []string{
@@ -33,57 +37,301 @@ var DefaultQuestions = []string{
 var lastDaysPattern = regexp.MustCompile(`^last\s+(\d+)\s+days?$`)
 
 func Run(args []string, build BuildInfo) error {
+	globals, args, err := ParseGlobalFlags(args)
+	if err != nil {
+		return err
+	}
+	SetQuiet(globals.Quiet)
+	SetConfigPath(globals.Config)
+
+	// restore runs before the config is loaded, since loading writes a default
+	// config that would then block restoring the archived one.
+	if len(args) > 0 && args[0] == "restore" {
+		return RunRestore(args[1:], globals.DryRun)
+	}
+
 	cfg, err := LoadConfig()
+	if errors.Is(err, ErrConfig) && needsConfig(args) {
+		return err
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "using default questions: %v\n", err)
+		Warnf("using default questions: %v\n", err)
 	}
 
 	if len(args) == 0 {
-		return RunPrompts(cfg.Questions)
+		silentEmpty := cfg.SilentEmptyEnabled()
+		if globals.SilentEmpty != nil {
+			silentEmpty = *globals.SilentEmpty
+		}
+		return RunPrompts(cfg, globals.DryRun, silentEmpty)
+	}
+	if name, _, _ := strings.Cut(args[0], "="); name == "--set" {
+		return RunSet(args, cfg, globals.DryRun)
 	}
 
 	switch args[0] {
 	case "view":
-		interval := strings.Join(args[1:], " ")
-		return RunView(interval, cfg.Questions)
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		if opts.Interval == "" && opts.Days == 0 && !opts.SinceLast {
+			opts.Interval = resolveViewInterval(cfg)
+		}
+		opts.NoEntriesMessage = cfg.NoEntriesText()
+		opts.Settings = cfg.Settings()
+		return RunView(opts, cfg.Questions)
 	case "cat":
-		interval := strings.Join(args[1:], " ")
-		return RunCat(interval, cfg.Questions)
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		opts.NoEntriesMessage = cfg.NoEntriesText()
+		opts.Settings = cfg.Settings()
+		return RunCat(opts, cfg.Questions)
+	case "last":
+		return RunLast(args[1:], cfg.Settings())
+	case "backup":
+		return RunBackup(args[1:], globals.DryRun)
+	case "prune":
+		return RunPrune(args[1:], globals.DryRun)
+	case "archive":
+		return RunArchive(args[1:], cfg.Settings(), globals.DryRun)
+	case "commit":
+		return RunCommit(args[1:], globals.DryRun)
+	case "words":
+		return RunWords(args[1:])
+	case "mood":
+		return RunMood(args[1:], cfg.Settings(), globals.DryRun)
+	case "rm":
+		return RunRm(args[1:], globals.DryRun)
+	case "trash":
+		return RunTrash(args[1:], cfg.Settings(), globals.DryRun)
+	case "carry":
+		return RunCarry(args[1:], cfg, globals.DryRun)
+	case "remind":
+		return RunRemind(args[1:], cfg)
+	case "dupes":
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		opts.Settings = cfg.Settings()
+		return RunDupes(opts, cfg.Questions)
+	case "stats":
+		return RunStats(args[1:], cfg.Questions)
+	case "import":
+		return RunImport(args[1:], cfg, globals.DryRun)
+	case "export":
+		return RunExport(args[1:], cfg.Questions, cfg.Settings())
+	case "digest":
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		opts.Settings = cfg.Settings()
+		return RunDigest(opts, cfg.Questions)
 	case "ls":
 		return RunLS(args[1:])
+	case "config":
+		return RunConfigCommand(args[1:], globals.DryRun)
+	case "add":
+		return RunAdd(args[1:], cfg, globals.DryRun)
+	case "rename-question":
+		return RunRenameQuestion(args[1:], cfg, globals.DryRun)
+	case "questions":
+		RunQuestions(cfg.Questions)
+		return nil
+	case "info":
+		return RunInfo()
+	case "open":
+		return RunOpen(args[1:])
+	case "grep":
+		return RunGrep(args[1:])
+	case "schema":
+		return RunSchema(args[1:])
+	case "completion":
+		return RunCompletion(args[1:])
 	case "help", "-h", "--help":
 		fmt.Println(UsageText())
 		return nil
 	case "version", "-v", "--version":
-		fmt.Printf("wlog %s %s %s\n", build.Commit, build.Ref, build.Version)
-		return nil
+		return RunVersion(args[1:], build)
 	default:
 		return fmt.Errorf("unknown command %q\n\n%s", args[0], UsageText())
 	}
 }
 
+// needsConfig reports whether the command in args depends on the config, so
+// an unreadable or invalid config file stops it with ExitConfig. The rest,
+// such as ls and config, still run so the broken file can be found and fixed.
+func needsConfig(args []string) bool {
+	if len(args) == 0 {
+		return true
+	}
+	switch args[0] {
+	case "ls", "config", "info", "open", "schema", "completion", "help", "-h", "--help", "version", "-v", "--version":
+		return false
+	}
+	return true
+}
+
+func resolveViewInterval(cfg Config) string {
+	interval := cfg.ViewInterval()
+	if _, _, err := ParseInterval(interval); err != nil {
+		Warnf("using today instead of default view interval: %v\n", err)
+		return ""
+	}
+	return interval
+}
+
 func UsageText() string {
 	return strings.TrimSpace(`wlog - a simple work log
 
 Usage:
   wlog                Run prompts for today's log
-  wlog view           Show today's entries
+  wlog --silent-empty [--dry-run]
+                      Run prompts without the "No entries recorded" line when nothing is answered
+  wlog --set <question>=<text> [--set ...]
+                      Add answers to several questions without prompting (questions selected as in wlog add)
+  wlog view           Show today's entries (or the configured defaultViewInterval)
   wlog view <interval>
-                      Show entries for a plain-english interval (e.g. "yesterday", "last 3 days", "last week", "this year")
+                      Show entries for a plain-english interval (e.g. "yesterday", "last 3 days", "last week", "this year", "tomorrow", "next week")
+  wlog view --after HH:MM --before HH:MM [interval]
+                      Only show entries logged within a time-of-day window
+  wlog view --empty [interval]
+                      Also list days in the interval that have no entries
+  wlog view --days N   Show entries for the last N days, including today
+  wlog view --count-only [interval]
+                      Print one line per day with its entry total and per-question counts
+  wlog view --include-archived [interval]
+                      Also read days moved to archive/ by wlog archive (also for cat and grep)
+  wlog view --strict [interval]
+                      Fail on the first unreadable day file instead of skipping it with a warning
+  wlog view --html-open [interval]
+                      Write an HTML report to the temp directory and open it in the browser
+  wlog view --markdown [interval]
+                      Print entries as Markdown headings and bullets
+  wlog view --flat [interval]
+                      List each day's entries as one time-sorted timeline tagged with their question
+  wlog view --reverse-entries [interval]
+                      List each question's answers newest first
+  wlog view --oneline [interval]
+                      Print every entry as "date question-key HH:MM text", sorted by time across days
+  wlog view --split-noon [interval]
+                      Group each question's entries under AM and PM sub-headers (also for cat)
+  wlog view --since-last
+                      Show entries added since the previous --since-last view, then move the marker
+  wlog view --json [interval]
+                      Print the days as a compact JSON array; --json-pretty indents it
+  wlog view --group-by <day|week|month> [interval]
+                      Print a header for each week or month ahead of the days it contains
+  wlog view --format <template> [interval]
+                      Render each day with a Go text/template; fields are .Date, .Questions
+                      (ordered) and .Answers (by question, each with .Time and .Response);
+                      {{time .Time}} formats a timestamp as HH:MM
   wlog cat             Print today's entries in list-view format
   wlog cat <interval>
                       Print entries in list-view format for a plain-english interval
+  wlog cat --plain [interval]
+                      Print the list view without relative-day labels or counts
+  wlog cat --no-empty-questions [interval]
+                      Leave out questions that have no answers that day
+  wlog cat --only-today-questions [interval]
+                      Hide questions that are in the day file but no longer configured (also for view;
+                      alias --config-only)
+  wlog last [--strict] [n]
+                      Show the n most recent entries across all days (default 1)
+  wlog words [--top N] [interval]
+                      Show the most frequent words in responses, ignoring common stopwords
+  wlog dupes [interval]
+                      List responses logged more than once under the same question
+  wlog stats [--ndays-active] [interval]
+                      Show entry totals per question; --ndays-active adds logging consistency
+  wlog export by-question [interval]
+                      Print every answer in the interval grouped by question
+  wlog export form [date]
+                      Print a blank fill-in form of the configured questions for a day
+  wlog import form [file]
+                      Add the answers from a filled-in form (stdin when file is omitted)
+  wlog digest [interval]
+                      Print one summary line per day with entries
   wlog ls              Print the log storage directory path
   wlog ls config       Print the config file path
+  wlog config edit     Open the interactive config editor
+  wlog config clean    Remove unknown _-prefixed default markers and rewrite the config normalized
+  wlog add [--priority high|low] [--date DATE] [--time HH:MM] <question> [text]
+                      Add an entry to today's log; reads stdin when text is omitted
+                      (--split stores each stdin line as its own entry; --date and --time backfill)
+  wlog add <text>     From a terminal, pick the question from a numbered list
+  wlog add --question-text <question> [text]
+                      Log against a question that is not in the config; views list it as an extra
+  wlog mood <1-5> [date]
+                      Rate a day from 1 to 5 (default today); shown as "Mood: N/5" in views
+  wlog mood --trend [interval]
+                      Show rated days in the interval with a sparkline and the average mood
+  wlog carry [date]    Copy the previous day's answers to the configured carryQuestions into date
+                      (default today), skipping responses that are already there
+  wlog trash [restore] [date]
+                      List entries deleted while softDelete is on, or restore them (default today)
+  wlog remind [--require <question>]...
+                      Exit with status 2 when nothing is logged today, or when a required question
+                      (selected as in wlog add) has no entry today
+  wlog rename-question <old> <new>
+                      Move answers from an old question text to a new one in every day file
+                      (its questionStyles entry in the config moves too)
+  wlog rm [--force] <date|interval>
+                      Remove the day files for a date or interval (e.g. "last 3 days") after confirming
+  wlog prune --empty [--force]
+                      Remove day files without any entries, asking first unless --force is given
+  wlog archive --older-than <N days>
+                      Move day files older than the cutoff into the archive/ subdirectory
+  wlog backup [dest]   Write a timestamped zip of the day files, archived ones too, and config file to dest
+                      (default: the current directory)
+  wlog restore [--force] <zip>
+                      Restore logs and config from a backup; --force overwrites existing files
+  wlog commit [message]
+                      Commit changes when the data directory is inside a git work tree
+  wlog questions       List configured questions with their 1-based index and TUI label
+  wlog info            Show storage paths, logged date range, and entry totals
+  wlog open            Open the log storage directory in the file manager
+  wlog open config     Reveal the config file in the file manager
+  wlog grep [--strict] [--include-archived] [--context] <term>
+                      Search day files and configured questions for a term
+                      (--context also lists the question's other answers that day)
+  wlog schema day|config
+                      Print the JSON Schema (draft-07) of day files or the config file
+  wlog completion <bash|zsh|fish>
+                      Print a shell completion script
   wlog help           Show this help message
-  wlog version        Show build metadata
+  wlog version [--json]
+                      Show build metadata, as {"commit","ref","version"} with --json
+
+Global flags (before the command):
+  --editor <command>  Editor to launch instead of $VISUAL/$EDITOR
+  --dry-run           Print the changes a command would make without writing them
+  --quiet             Suppress non-fatal warnings on stderr
+  --config <path>     Use this config file; a .yaml or .yml extension selects YAML
+
+Exit codes:
+  0                   Success
+  1                   Any other error
+  2                   view or cat found no entries, or remind found something missing
+  3                   The config file could not be read or parsed
+
+Environment:
+  WLOG_DATA_DIR       Directory for day files (overrides XDG_DATA_HOME)
+  WLOG_CONFIG_FILE    Path to the config file (overrides XDG_CONFIG_HOME)
+  WLOG_QUIET          Set to 1 or true to behave as if --quiet was passed
 
 Examples:
   wlog
   wlog ls
   wlog ls config
   wlog view yesterday
-  wlog view "last 3 days"`)
+  wlog view "last 3 days"
+  wlog add 2 "reviewed PRs"
+  echo "did X" | wlog add yesterday`)
 }
 
 func RunLS(args []string) error {
@@ -117,7 +365,25 @@ func RunLS(args []string) error {
 	return nil
 }
 
-func RunPrompts(questions []string) error {
+func RunQuestions(questions []string) {
+	if len(questions) == 0 {
+		fmt.Println("No questions configured.")
+		return
+	}
+	for idx, q := range questions {
+		label := "--"
+		if idx < len(listIndexRunes) {
+			label = string(listIndexRunes[idx])
+		}
+		fmt.Printf("%2d  [%s] %s\n", idx+1, label, q)
+	}
+}
+
+// RunPrompts asks each configured question on stdin and saves the answers to
+// today's log. With silentEmpty, a run that records nothing skips the
+// "No entries recorded" line.
+func RunPrompts(cfg Config, dryRun, silentEmpty bool) error {
+	questions := cfg.Questions
 	if len(questions) == 0 {
 		fmt.Println("No questions configured. Update your config file to add some.")
 		return nil
@@ -129,104 +395,320 @@ func RunPrompts(questions []string) error {
 		return err
 	}
 
-	fmt.Println("Answer the following questions. Press Enter to skip any question.")
+	settings := cfg.Settings()
+	placeholder := cfg.SkipAnswerPlaceholder()
+	if placeholder != "" {
+		fmt.Printf("Answer the following questions. Press Enter to record %q.\n", placeholder)
+	} else {
+		fmt.Println("Answer the following questions. Press Enter to skip any question.")
+	}
 	reader := bufio.NewReader(os.Stdin)
-	updated := false
+	var added []plannedAnswer
 
+	if cfg.ShuffleQuestionsEnabled() {
+		questions = shuffleQuestions(questions, today.Unix())
+	}
 	for _, q := range questions {
-		fmt.Printf("%s\n> ", q)
+		fmt.Printf("%s\n> ", RenderQuestion(q, today))
 		text, err := reader.ReadString('\n')
 		if err != nil && !errors.Is(err, io.EOF) {
 			return err
 		}
 		response := strings.TrimSpace(text)
+		if response == "" && placeholder == "" {
+			continue
+		}
 		if response == "" {
+			response = placeholder
+		}
+		if cfg.DedupeEntriesEnabled() && HasResponse(log.Answers[q], response) {
+			fmt.Println("Duplicate skipped.")
 			continue
 		}
 		if log.Answers == nil {
 			log.Answers = make(map[string][]Answer)
 		}
-		log.Answers[q] = append(log.Answers[q], Answer{
-			Time:     time.Now().Format(time.RFC3339),
+		ans := Answer{
+			Time:     settings.EntryTimestamp(time.Now()),
 			Response: response,
-		})
-		updated = true
+		}
+		log.Answers[q] = append(log.Answers[q], ans)
+		added = append(added, plannedAnswer{Question: q, Answer: ans})
+		if warning := longResponseWarning(response, cfg.WarnResponseLength()); warning != "" {
+			fmt.Println(warning)
+		}
 	}
 
-	if !updated {
-		fmt.Println("No entries recorded today.")
+	if len(added) == 0 {
+		if !silentEmpty {
+			fmt.Println("No entries recorded today.")
+		}
 		return nil
 	}
 
-	if err := SaveDayLog(today, log); err != nil {
+	if dryRun {
+		path, err := DayFilePath(today)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, added, settings)
+		return nil
+	}
+
+	if err := SaveDayLog(today, log, settings); err != nil {
 		return err
 	}
 
 	fmt.Println("Entries saved.")
+	fmt.Println()
+	printDayLog(addedDayLog(today, added), cfg.Questions, ViewOptions{Settings: settings})
+	return nil
+}
+
+// addedDayLog collects just the answers recorded in this run, so they can be
+// echoed back in the view format.
+func addedDayLog(day time.Time, added []plannedAnswer) DayLog {
+	log := DayLog{Date: day.Format("2006-01-02"), Answers: make(map[string][]Answer)}
+	for _, p := range added {
+		log.Answers[p.Question] = append(log.Answers[p.Question], p.Answer)
+	}
+	return log
+}
+
+// RunVersion prints the build metadata, as a JSON object with --json.
+func RunVersion(args []string, build BuildInfo) error {
+	asJSON := false
+	for _, arg := range args {
+		if arg != "--json" {
+			return fmt.Errorf("unknown version argument %q", arg)
+		}
+		asJSON = true
+	}
+	if !asJSON {
+		fmt.Printf("wlog %s %s %s\n", build.Commit, build.Ref, build.Version)
+		return nil
+	}
+	data, err := json.Marshal(build)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
 	return nil
 }
 
-func RunView(interval string, questions []string) error {
-	start, end, err := ParseInterval(interval)
+// printLogsJSON writes logs as a JSON array, on one line unless pretty is set
+// so the default pipes cleanly into line-oriented tools.
+func printLogsJSON(logs []DayLog, pretty bool) error {
+	if logs == nil {
+		logs = []DayLog{}
+	}
+	for i := range logs {
+		logs[i].Trash = nil
+	}
+	var data []byte
+	var err error
+	if pretty {
+		data, err = json.MarshalIndent(logs, "", "  ")
+	} else {
+		data, err = json.Marshal(logs)
+	}
 	if err != nil {
 		return err
 	}
+	fmt.Println(string(data))
+	if len(logs) == 0 {
+		return ErrNoEntries
+	}
+	return nil
+}
+
+// longResponseWarning returns a nudge when response is longer than limit
+// characters. A limit of 0 disables it; the response is stored either way.
+func longResponseWarning(response string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	if n := utf8.RuneCountInString(response); n > limit {
+		return fmt.Sprintf("(that was %d chars)", n)
+	}
+	return ""
+}
+
+func RunView(opts ViewOptions, questions []string) error {
+	if !opts.SinceLast {
+		return runView(opts, questions)
+	}
+	viewedAt := time.Now()
+	state, err := readState()
+	if err != nil {
+		return err
+	}
+	if opts.since, err = state.lastViewed(); err != nil {
+		return err
+	}
+	err = runView(opts, questions)
+	if err == nil || errors.Is(err, ErrNoEntries) {
+		if markErr := markViewed(viewedAt, opts.Settings); markErr != nil {
+			return markErr
+		}
+	}
+	return err
+}
+
+func runView(opts ViewOptions, questions []string) error {
+	if opts.HTMLOpen {
+		return openHTMLReport(opts, questions)
+	}
+	start, end, err := opts.bounds()
+	if err != nil {
+		return err
+	}
+	var format *template.Template
+	if opts.Format != "" {
+		if format, err = parseViewFormat(opts.Format, opts.Settings); err != nil {
+			return err
+		}
+	}
 
 	var logs []DayLog
 	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
-		entry, err := ReadDayLogIfExists(cursor)
+		entry, err := opts.readDay(cursor)
 		if err != nil {
 			return err
 		}
-		if entry != nil {
-			logs = append(logs, *entry)
+		if entry == nil {
+			if opts.Empty {
+				logs = append(logs, DayLog{Date: cursor.Format("2006-01-02")})
+			}
+			continue
 		}
+		filtered := opts.filterDayLog(*entry)
+		if opts.hasTimeWindow() && !dayLogHasEntries(filtered) && !opts.Empty {
+			continue
+		}
+		logs = append(logs, filtered)
+	}
+
+	if opts.JSON || opts.JSONPretty {
+		return printLogsJSON(logs, opts.JSONPretty)
 	}
 
 	if len(logs) == 0 {
-		if interval == "" {
-			interval = "today"
-		}
-		fmt.Printf("No entries found for %s.\n", interval)
+		fmt.Println(opts.noEntriesMessage())
+		return ErrNoEntries
+	}
+	if opts.Oneline {
+		fmt.Print(renderOneline(logs, questions, opts.Settings))
 		return nil
 	}
 
+	var period time.Time
+	var totals viewTotals
 	for _, day := range logs {
-		printDayLog(day, questions)
+		totals.add(day)
+		if opts.GroupBy != "" && opts.GroupBy != GroupByDay {
+			if date, err := time.ParseInLocation("2006-01-02", day.Date, time.Local); err == nil {
+				if start := periodStart(date, opts.GroupBy); !start.Equal(period) {
+					period = start
+					fmt.Print(periodHeader(start, opts.GroupBy, opts.Settings))
+				}
+			}
+		}
+		if format != nil {
+			if err := executeViewFormat(os.Stdout, format, day, questions); err != nil {
+				return err
+			}
+			continue
+		}
+		if opts.CountOnly {
+			fmt.Print(renderDayCount(day, questions))
+			continue
+		}
+		if opts.Empty && !dayLogHasContent(day) {
+			fmt.Printf("%s — no entries\n\n", day.Date)
+			continue
+		}
+		if opts.Flat {
+			fmt.Print(renderFlatDay(day, questions, opts.Settings))
+			continue
+		}
+		if opts.Markdown {
+			fmt.Print(renderMarkdown(day, questions, opts.Settings))
+			continue
+		}
+		printDayLog(day, questions, opts)
+	}
+	if format == nil {
+		fmt.Print(totals.footer())
 	}
 
 	return nil
 }
 
-func RunCat(interval string, questions []string) error {
-	start, end, err := ParseInterval(interval)
+func RunCat(opts ViewOptions, questions []string) error {
+	start, end, err := opts.bounds()
 	if err != nil {
 		return err
 	}
 
-	trimmed := strings.ToLower(strings.TrimSpace(interval))
+	trimmed := strings.ToLower(strings.TrimSpace(opts.Interval))
 	forceSingleDay := start.Equal(end) && (trimmed == "" || trimmed == "today")
-	printed := false
+	var totals viewTotals
 
 	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
-		log, err := LoadDayLog(cursor)
+		entry, err := opts.readDay(cursor)
 		if err != nil {
 			return err
 		}
-		if !forceSingleDay && !dayLogHasEntries(log) {
+		log := DayLog{Date: cursor.Format("2006-01-02"), Answers: make(map[string][]Answer)}
+		if entry != nil {
+			log = opts.filterDayLog(*entry)
+		}
+		if !forceSingleDay && !dayLogHasContent(log) {
 			continue
 		}
-		fmt.Print(renderListView(cursor, log, questions))
-		printed = true
+		fmt.Print(renderListView(cursor, log, questions, opts))
+		totals.add(log)
 	}
 
-	if !printed {
-		fmt.Printf("No entries found for %s.\n", intervalLabel(interval))
+	if totals.days == 0 {
+		fmt.Println(opts.noEntriesMessage())
+		return ErrNoEntries
 	}
+	fmt.Print(totals.footer())
 
 	return nil
 }
 
+// shuffleQuestions returns a copy of questions in an order determined by
+// seed. RunPrompts seeds it with the day, so the order changes daily but
+// stays put when prompting again on the same day.
+func shuffleQuestions(questions []string, seed int64) []string {
+	shuffled := append([]string(nil), questions...)
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// HasResponse reports whether answers already contain the given response,
+// ignoring surrounding whitespace.
+func HasResponse(answers []Answer, response string) bool {
+	response = strings.TrimSpace(response)
+	for _, ans := range answers {
+		if strings.TrimSpace(ans.Response) == response {
+			return true
+		}
+	}
+	return false
+}
+
+// dayLogHasContent reports whether log has entries or a mood to show.
+func dayLogHasContent(log DayLog) bool {
+	return log.Mood != 0 || dayLogHasEntries(log)
+}
+
 func dayLogHasEntries(log DayLog) bool {
 	for _, answers := range log.Answers {
 		if len(answers) > 0 {
@@ -238,16 +720,26 @@ func dayLogHasEntries(log DayLog) bool {
 
 var listIndexRunes = []rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z'}
 
-func renderListView(day time.Time, log DayLog, base []string) string {
+func renderListView(day time.Time, log DayLog, base []string, opts ViewOptions) string {
 	if log.Answers == nil {
 		log.Answers = make(map[string][]Answer)
 	}
 
 	var b strings.Builder
-	dayLabel := day.Format("Mon 2006-01-02")
-	b.WriteString(fmt.Sprintf("%s — %s\n\n", dayLabel, relativeDayLabel(day)))
+	dayLabel := opts.Settings.FormatDayLabel(day)
+	if opts.Plain {
+		b.WriteString(fmt.Sprintf("%s\n\n", dayLabel))
+	} else {
+		b.WriteString(fmt.Sprintf("%s — %s\n\n", dayLabel, opts.Settings.RelativeDayLabel(day)))
+	}
+	if mood := FormatMood(log.Mood); mood != "" {
+		b.WriteString(mood + "\n\n")
+	}
 
 	ordered := mergeQuestionsForList(base, log)
+	if opts.OnlyConfigQuestions {
+		ordered = configuredOnly(ordered, base)
+	}
 	if len(ordered) == 0 {
 		b.WriteString("No questions configured.\n\n")
 		return b.String()
@@ -255,18 +747,19 @@ func renderListView(day time.Time, log DayLog, base []string) string {
 
 	for idx, q := range ordered {
 		answers := log.Answers[q]
+		if opts.NoEmptyQuestions && len(answers) == 0 {
+			continue
+		}
 		label := "--"
 		if idx < len(listIndexRunes) {
 			label = string(listIndexRunes[idx])
 		}
 		countLabel := ""
-		if len(answers) > 0 {
+		if len(answers) > 0 && !opts.Plain {
 			countLabel = fmt.Sprintf(" (%d)", len(answers))
 		}
-		b.WriteString(fmt.Sprintf("[%s] %s%s\n", label, q, countLabel))
-		for _, ans := range answers {
-			b.WriteString(fmt.Sprintf("    - [%s] %s\n", DisplayTime(ans.Time), ans.Response))
-		}
+		b.WriteString(fmt.Sprintf("[%s] %s%s\n", label, RenderQuestion(q, day), countLabel))
+		b.WriteString(formatAnswers(opts.entryOrder(answers), "    ", opts.SplitNoon, opts.Settings))
 	}
 
 	b.WriteString("\n")
@@ -295,23 +788,6 @@ func mergeQuestionsForList(base []string, log DayLog) []string {
 	return list
 }
 
-func relativeDayLabel(day time.Time) string {
-	today := DayFloor(time.Now())
-	switch {
-	case day.Equal(today):
-		return "Today"
-	case day.Equal(today.AddDate(0, 0, -1)):
-		return "Yesterday"
-	case day.Equal(today.AddDate(0, 0, 1)):
-		return "Tomorrow"
-	}
-	delta := int(day.Sub(today).Hours() / 24)
-	if delta > 0 {
-		return fmt.Sprintf("In %d days", delta)
-	}
-	return fmt.Sprintf("%d days ago", -delta)
-}
-
 func intervalLabel(raw string) string {
 	trimmed := strings.TrimSpace(raw)
 	if trimmed == "" {
@@ -320,24 +796,45 @@ func intervalLabel(raw string) string {
 	return trimmed
 }
 
-func printDayLog(day DayLog, questions []string) {
+func printDayLog(day DayLog, questions []string, opts ViewOptions) {
 	fmt.Printf("%s\n", day.Date)
+	if mood := FormatMood(day.Mood); mood != "" {
+		fmt.Printf("  %s\n", mood)
+	}
+	date, _ := time.ParseInLocation("2006-01-02", day.Date, time.Local)
 
 	ordered := OrderQuestions(day.Answers, questions)
+	if opts.OnlyConfigQuestions {
+		ordered = configuredOnly(ordered, questions)
+	}
 	for _, q := range ordered {
 		answers := day.Answers[q]
 		if len(answers) == 0 {
 			continue
 		}
-		fmt.Printf("  %s\n", q)
-		for _, ans := range answers {
-			fmt.Printf("    - [%s] %s\n", DisplayTime(ans.Time), ans.Response)
-		}
+		fmt.Printf("  %s\n", RenderQuestion(q, date))
+		fmt.Print(formatAnswers(opts.entryOrder(answers), "    ", opts.SplitNoon, opts.Settings))
 	}
 
 	fmt.Println()
 }
 
+// configuredOnly drops the extra questions merged in from a day file, keeping
+// those that appear in base.
+func configuredOnly(ordered, base []string) []string {
+	configured := make(map[string]bool, len(base))
+	for _, q := range base {
+		configured[q] = true
+	}
+	kept := make([]string, 0, len(ordered))
+	for _, q := range ordered {
+		if configured[q] {
+			kept = append(kept, q)
+		}
+	}
+	return kept
+}
+
 func OrderQuestions(answers map[string][]Answer, base []string) []string {
 	seen := make(map[string]bool)
 	ordered := make([]string, 0, len(answers))
@@ -368,6 +865,9 @@ func ParseInterval(raw string) (time.Time, time.Time, error) {
 	case "yesterday":
 		day := now.AddDate(0, 0, -1)
 		return day, day, nil
+	case "tomorrow":
+		day := now.AddDate(0, 0, 1)
+		return day, day, nil
 	case "last week":
 		end := StartOfWeek(now).AddDate(0, 0, -1)
 		start := end.AddDate(0, 0, -6)
@@ -375,6 +875,10 @@ func ParseInterval(raw string) (time.Time, time.Time, error) {
 	case "this week":
 		start := StartOfWeek(now)
 		return start, now, nil
+	case "next week":
+		start := StartOfWeek(now).AddDate(0, 0, 7)
+		end := start.AddDate(0, 0, 6)
+		return start, end, nil
 	case "this year":
 		start := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
 		return start, now, nil
@@ -385,13 +889,17 @@ func ParseInterval(raw string) (time.Time, time.Time, error) {
 		if err != nil || days <= 0 {
 			return time.Time{}, time.Time{}, fmt.Errorf("invalid day count in interval %q", raw)
 		}
-		start := now.AddDate(0, 0, -(days - 1))
-		return start, now, nil
+		start, end := lastDaysRange(now, days)
+		return start, end, nil
 	}
 
 	return time.Time{}, time.Time{}, fmt.Errorf("unsupported interval %q", raw)
 }
 
+func lastDaysRange(today time.Time, days int) (time.Time, time.Time) {
+	return today.AddDate(0, 0, -(days - 1)), today
+}
+
 func StartOfWeek(t time.Time) time.Time {
 	base := DayFloor(t)
 	weekday := int(base.Weekday())
@@ -408,6 +916,16 @@ func DayFloor(t time.Time) time.Time {
 	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
 }
 
+// DaysBetween counts calendar days from from to to, so a day that is 23 or
+// 25 hours long across a DST change still counts as one.
+func DaysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	start := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	end := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
+
 func LoadConfig() (Config, error) {
 	path, err := ConfigFilePath()
 	if err != nil {
@@ -428,29 +946,72 @@ func LoadConfig() (Config, error) {
 	if err != nil {
 		cfg := Config{Questions: DefaultQuestions}
 		cfg.ensureDefaults()
-		return cfg, err
+		return cfg, newConfigError(err)
 	}
 
-	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
+	raw, err := decodeConfigData(path, data)
+	if err == nil {
+		if err = checkSchemaVersion(rawSchemaVersion(raw)); err != nil {
+			err = fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+	}
+	if err != nil {
+		cfg := Config{Questions: DefaultQuestions}
+		cfg.ensureDefaults()
+		return cfg, newConfigError(err)
+	}
+	cfg, err := configFromMap(raw)
+	if err != nil {
 		cfg = Config{Questions: DefaultQuestions}
 		cfg.ensureDefaults()
-		return cfg, err
+		return cfg, newConfigError(err)
 	}
 	cfg.ensureDefaults()
 
-	var raw map[string]any
-	if err := json.Unmarshal(data, &raw); err == nil {
-		if applyDefaultMarkers(raw) {
-			if err := writeConfigMap(path, raw); err != nil {
-				return cfg, err
-			}
+	if applyDefaultMarkers(raw) {
+		if err := writeConfigMap(path, raw); err != nil {
+			return cfg, err
 		}
 	}
 
 	return cfg, nil
 }
 
+func isYAMLConfig(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// decodeConfigData parses a config file as YAML or JSON depending on its
+// extension.
+func decodeConfigData(path string, data []byte) (map[string]any, error) {
+	if isYAMLConfig(path) {
+		raw, err := decodeYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		return raw, nil
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func configFromMap(raw map[string]any) (Config, error) {
+	var cfg Config
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return cfg, err
+	}
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
+
 func SaveConfig(cfg Config) error {
 	path, err := ConfigFilePath()
 	if err != nil {
@@ -469,6 +1030,9 @@ func writeConfig(path string, cfg Config) error {
 		}
 		raw = make(map[string]any)
 	}
+	if err := checkSchemaVersion(rawSchemaVersion(raw)); err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
 
 	applyConfigToMap(raw, cfg)
 	applyDefaultMarkers(raw)
@@ -480,25 +1044,50 @@ func readConfigMap(path string) (map[string]any, error) {
 	if err != nil {
 		return nil, err
 	}
-	var raw map[string]any
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return nil, err
-	}
-	return raw, nil
+	return decodeConfigData(path, data)
 }
 
 func writeConfigMap(path string, raw map[string]any) error {
 	if err := EnsureDir(filepath.Dir(path)); err != nil {
 		return err
 	}
-	data, err := json.MarshalIndent(raw, "", "  ")
+	var data []byte
+	var err error
+	if isYAMLConfig(path) {
+		data, err = encodeYAML(raw)
+	} else {
+		compact, _ := raw["compactStorage"].(bool)
+		data, err = marshalStored(raw, compact)
+	}
 	if err != nil {
 		return err
 	}
 	return os.WriteFile(path, data, 0o644)
 }
 
+func resolveDisplayLocation(name string) (*time.Location, error) {
+	switch strings.ToLower(name) {
+	case "", "local":
+		return time.Local, nil
+	case "utc":
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown displayTimezone %q", name)
+	}
+	return loc, nil
+}
+
+func marshalStored(v any, compact bool) ([]byte, error) {
+	if compact {
+		return json.Marshal(v)
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
 func applyConfigToMap(raw map[string]any, cfg Config) {
+	raw["schemaVersion"] = SchemaVersion
 	raw["questions"] = append([]string(nil), cfg.Questions...)
 	setOptionalBool(raw, "showHints", cfg.ShowHints)
 	setOptionalBool(raw, "autoInsertEntries", cfg.AutoInsertEntries)
@@ -509,6 +1098,25 @@ func applyConfigToMap(raw map[string]any, cfg Config) {
 	setOptionalBool(raw, "confirmEscapeWithText", cfg.ConfirmEscapeWithText)
 	setOptionalInt(raw, "statusMessageDurationMs", cfg.StatusMessageDurationMs)
 	setOptionalInt(raw, "escapeConfirmTimeoutMs", cfg.EscapeConfirmTimeoutMs)
+	setOptionalString(raw, "defaultViewInterval", cfg.DefaultViewInterval)
+	setOptionalQuestionStyles(raw, "questionStyles", cfg.QuestionStyles)
+	setOptionalStrings(raw, "carryQuestions", cfg.CarryQuestions)
+	setOptionalStrings(raw, "editors", cfg.Editors)
+	setOptionalBool(raw, "dedupeEntries", cfg.DedupeEntries)
+	setOptionalInt(raw, "entrySoftLimitChars", cfg.EntrySoftLimitChars)
+	setOptionalString(raw, "skipPlaceholder", cfg.SkipPlaceholder)
+	setOptionalBool(raw, "compactStorage", cfg.CompactStorage)
+	setOptionalString(raw, "displayTimezone", cfg.DisplayTimezone)
+	setOptionalBool(raw, "shuffleQuestions", cfg.ShuffleQuestions)
+	setOptionalString(raw, "statusPosition", cfg.StatusPosition)
+	setOptionalString(raw, "noEntriesMessage", cfg.NoEntriesMessage)
+	setOptionalInt(raw, "warnResponseLen", cfg.WarnResponseLen)
+	setOptionalString(raw, "timestampPrecision", cfg.TimestampPrecision)
+	setOptionalString(raw, "locale", cfg.Locale)
+	setOptionalBool(raw, "silentEmpty", cfg.SilentEmpty)
+	setOptionalBool(raw, "softDelete", cfg.SoftDelete)
+	setOptionalBool(raw, "allowFutureEntries", cfg.AllowFutureEntries)
+	setOptionalBool(raw, "orderedAnswers", cfg.OrderedAnswers)
 }
 
 func setOptionalBool(raw map[string]any, key string, value *bool) {
@@ -527,6 +1135,37 @@ func setOptionalInt(raw map[string]any, key string, value *int) {
 	raw[key] = *value
 }
 
+func setOptionalString(raw map[string]any, key string, value string) {
+	if value == "" {
+		delete(raw, key)
+		return
+	}
+	raw[key] = value
+}
+
+func setOptionalStrings(raw map[string]any, key string, value []string) {
+	if len(value) == 0 {
+		delete(raw, key)
+		return
+	}
+	raw[key] = append([]string(nil), value...)
+}
+
+func setOptionalQuestionStyles(raw map[string]any, key string, value map[string]QuestionStyle) {
+	if len(value) == 0 {
+		delete(raw, key)
+		return
+	}
+	styles := make(map[string]any, len(value))
+	for q, style := range value {
+		entry := make(map[string]any)
+		setOptionalString(entry, "color", style.Color)
+		setOptionalString(entry, "icon", style.Icon)
+		styles[q] = entry
+	}
+	raw[key] = styles
+}
+
 func applyDefaultMarkers(raw map[string]any) bool {
 	changed := false
 	for key, value := range defaultConfigMarkers {
@@ -559,13 +1198,27 @@ func configValuesEqual(a, b any) bool {
 	return reflect.DeepEqual(a, b)
 }
 
+var configPathOverride string
+
+// SetConfigPath makes ConfigFilePath return path, as set by the --config
+// flag. An empty path restores the usual lookup.
+func SetConfigPath(path string) {
+	configPathOverride = path
+}
+
 func ConfigFilePath() (string, error) {
+	if configPathOverride != "" {
+		return configPathOverride, nil
+	}
+	if path := os.Getenv("WLOG_CONFIG_FILE"); path != "" {
+		return path, nil
+	}
 	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
-		return filepath.Join(xdg, "wlog", "config.json"), nil
+		return configFileIn(filepath.Join(xdg, "wlog")), nil
 	}
 	if runtime.GOOS == "windows" {
 		if appData := os.Getenv("AppData"); appData != "" {
-			return filepath.Join(appData, "wlog", "config.json"), nil
+			return configFileIn(filepath.Join(appData, "wlog")), nil
 		}
 	}
 	home, err := os.UserHomeDir()
@@ -573,12 +1226,42 @@ func ConfigFilePath() (string, error) {
 		return "", err
 	}
 	if runtime.GOOS == "darwin" {
-		return filepath.Join(home, "Library", "Application Support", "wlog", "config.json"), nil
+		return configFileIn(filepath.Join(home, "Library", "Application Support", "wlog")), nil
+	}
+	return configFileIn(filepath.Join(home, ".config", "wlog")), nil
+}
+
+// configFileIn picks the config file in dir: config.json when it exists,
+// otherwise an existing config.yaml or config.yml, and config.json for a
+// fresh setup.
+func configFileIn(dir string) string {
+	for _, name := range []string{"config.json", "config.yaml", "config.yml"} {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
 	}
-	return filepath.Join(home, ".config", "wlog", "config.json"), nil
+	return filepath.Join(dir, "config.json")
 }
 
+// DataDir returns the log storage directory. It fails early when something
+// other than a directory already sits at that path, since every read and write
+// would otherwise break with a less obvious error.
 func DataDir() (string, error) {
+	dir, err := dataDirPath()
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		return "", fmt.Errorf("data path exists as a file: %s (move or remove it so wlog can create its data directory)", dir)
+	}
+	return dir, nil
+}
+
+func dataDirPath() (string, error) {
+	if dir := os.Getenv("WLOG_DATA_DIR"); dir != "" {
+		return dir, nil
+	}
 	if xdg := os.Getenv("XDG_DATA_HOME"); xdg != "" {
 		return filepath.Join(xdg, "wlog"), nil
 	}
@@ -597,7 +1280,14 @@ func DataDir() (string, error) {
 	return filepath.Join(home, ".local", "share", "wlog"), nil
 }
 
+// DayFilePath returns the file that holds the log for date: the existing
+// one found by findDayFile, so an archived day is updated in place, or a new
+// file in DataDir, which is created if needed.
 func DayFilePath(date time.Time) (string, error) {
+	path, err := findDayFile(date, true)
+	if err != nil || path != "" {
+		return path, err
+	}
 	dir, err := DataDir()
 	if err != nil {
 		return "", err
@@ -605,11 +1295,117 @@ func DayFilePath(date time.Time) (string, error) {
 	if err := EnsureDir(dir); err != nil {
 		return "", err
 	}
-	name := fmt.Sprintf("%s.json", date.Format("2006-01-02"))
-	return filepath.Join(dir, name), nil
+	return filepath.Join(dir, dayFileName(date)), nil
+}
+
+// findDayFile returns the existing day file for date, looking in DataDir and
+// then, with includeArchived, in its archive subdirectory. It returns "" when
+// there is none and never creates directories.
+func findDayFile(date time.Time, includeArchived bool) (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	candidates := []string{filepath.Join(dir, dayFileName(date))}
+	if includeArchived {
+		candidates = append(candidates, filepath.Join(dir, archiveDirName, dayFileName(date)))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func dayFileName(date time.Time) string {
+	return date.Format("2006-01-02") + ".json"
+}
+
+func ListDayFiles() ([]string, error) {
+	return listDayFiles(false)
+}
+
+// listDayFiles returns day file paths sorted by date. Archived days live in a
+// subdirectory of DataDir and are only listed when includeArchived is set.
+func listDayFiles(includeArchived bool) ([]string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return nil, err
+	}
+	paths, err := dayFilesIn(dir)
+	if err != nil || !includeArchived {
+		return paths, err
+	}
+	archived, err := dayFilesIn(filepath.Join(dir, archiveDirName))
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, archived...)
+	sort.SliceStable(paths, func(i, j int) bool {
+		return filepath.Base(paths[i]) < filepath.Base(paths[j])
+	})
+	return paths, nil
+}
+
+func dayFilesIn(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if _, ok := dayFromFileName(entry.Name()); !ok {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func dayFromFileName(name string) (time.Time, bool) {
+	base, ok := strings.CutSuffix(name, ".json")
+	if !ok {
+		return time.Time{}, false
+	}
+	day, err := time.ParseInLocation("2006-01-02", base, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+func readDayLogFile(path string) (DayLog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DayLog{}, err
+	}
+	var log DayLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return DayLog{}, err
+	}
+	if err := checkSchemaVersion(log.SchemaVersion); err != nil {
+		return DayLog{}, err
+	}
+	if log.Answers == nil {
+		log.Answers = make(map[string][]Answer)
+	}
+	return log, nil
 }
 
 func EnsureDir(path string) error {
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		return fmt.Errorf("path exists as a file, expected a directory: %s", path)
+	}
 	return os.MkdirAll(path, 0o755)
 }
 
@@ -630,54 +1426,48 @@ func LoadDayLog(date time.Time) (DayLog, error) {
 	return *entry, nil
 }
 
+// ReadDayLogIfExists reads the log for date, archived or not, returning nil
+// when the day has no file.
 func ReadDayLogIfExists(date time.Time) (*DayLog, error) {
-	path, err := DayFilePath(date)
-	if err != nil {
+	return readDayLog(date, true)
+}
+
+// readDayLog reads the log for date found by findDayFile, returning nil when
+// the day has no file.
+func readDayLog(date time.Time, includeArchived bool) (*DayLog, error) {
+	path, err := findDayFile(date, includeArchived)
+	if err != nil || path == "" {
 		return nil, err
 	}
-	data, err := os.ReadFile(path)
-	if errors.Is(err, fs.ErrNotExist) {
-		return nil, nil
-	}
+	log, err := readDayLogFile(path)
 	if err != nil {
-		return nil, err
-	}
-	var log DayLog
-	if err := json.Unmarshal(data, &log); err != nil {
-		return nil, err
-	}
-	if log.Answers == nil {
-		log.Answers = make(map[string][]Answer)
+		return nil, fmt.Errorf("%s: %w", path, err)
 	}
 	return &log, nil
 }
 
-func SaveDayLog(date time.Time, log DayLog) error {
+func SaveDayLog(date time.Time, log DayLog, settings Settings) error {
 	path, err := DayFilePath(date)
 	if err != nil {
 		return err
 	}
+	return writeDayLogFile(path, date, log, settings)
+}
+
+// writeDayLogFile writes log as the day file for date at path.
+func writeDayLogFile(path string, date time.Time, log DayLog, settings Settings) error {
+	log.SchemaVersion = SchemaVersion
 	log.Date = date.Format("2006-01-02")
 	if log.Answers == nil {
 		log.Answers = make(map[string][]Answer)
 	}
-	data, err := json.MarshalIndent(log, "", "  ")
+	data, err := encodeDayLog(log, settings)
 	if err != nil {
 		return err
 	}
 	return os.WriteFile(path, data, 0o644)
 }
 
-func DisplayTime(value string) string {
-	if value == "" {
-		return ""
-	}
-	if t, err := time.Parse(time.RFC3339, value); err == nil {
-		return t.Format("15:04")
-	}
-	return value
-}
-
 const (
 	defaultShowHints               = true
 	defaultAutoInsertEntries       = true
@@ -688,6 +1478,22 @@ const (
 	defaultContinueInsertAfterSave = true
 	defaultConfirmEscapeWithText   = true
 	defaultEscapeConfirmTimeoutMs  = 1000
+	defaultViewInterval            = "today"
+	defaultDedupeEntries           = false
+	defaultEntrySoftLimitChars     = 0
+	defaultSkipPlaceholder         = ""
+	defaultCompactStorage          = false
+	defaultDisplayTimezone         = "local"
+	defaultShuffleQuestions        = false
+	defaultStatusPosition          = StatusPositionInline
+	defaultNoEntriesMessage        = "No entries found for %s."
+	defaultWarnResponseLen         = 0
+	defaultTimestampPrecision      = TimestampPrecisionSecond
+	defaultLocale                  = "en"
+	defaultSilentEmpty             = false
+	defaultSoftDelete              = false
+	defaultAllowFutureEntries      = false
+	defaultOrderedAnswers          = false
 )
 
 var defaultConfigMarkers = map[string]any{
@@ -700,29 +1506,185 @@ var defaultConfigMarkers = map[string]any{
 	"_continueInsertAfterSave": defaultContinueInsertAfterSave,
 	"_confirmEscapeWithText":   defaultConfirmEscapeWithText,
 	"_escapeConfirmTimeoutMs":  float64(defaultEscapeConfirmTimeoutMs),
+	"_defaultViewInterval":     defaultViewInterval,
+	"_dedupeEntries":           defaultDedupeEntries,
+	"_entrySoftLimitChars":     float64(defaultEntrySoftLimitChars),
+	"_skipPlaceholder":         defaultSkipPlaceholder,
+	"_compactStorage":          defaultCompactStorage,
+	"_displayTimezone":         defaultDisplayTimezone,
+	"_shuffleQuestions":        defaultShuffleQuestions,
+	"_statusPosition":          defaultStatusPosition,
+	"_noEntriesMessage":        defaultNoEntriesMessage,
+	"_warnResponseLen":         float64(defaultWarnResponseLen),
+	"_timestampPrecision":      defaultTimestampPrecision,
+	"_locale":                  defaultLocale,
+	"_silentEmpty":             defaultSilentEmpty,
+	"_softDelete":              defaultSoftDelete,
+	"_allowFutureEntries":      defaultAllowFutureEntries,
+	"_orderedAnswers":          defaultOrderedAnswers,
 }
 
 type Config struct {
-	Questions               []string `json:"questions"`
-	ShowHints               *bool    `json:"showHints,omitempty"`
-	AutoInsertEntries       *bool    `json:"autoInsertEntries,omitempty"`
-	DefaultListMode         *bool    `json:"defaultListMode,omitempty"`
-	AutoOpenIndexJump       *bool    `json:"autoOpenIndexJump,omitempty"`
-	ConfirmDelete           *bool    `json:"confirmDelete,omitempty"`
-	ContinueInsertAfterSave *bool    `json:"continueInsertAfterSave,omitempty"`
-	ConfirmEscapeWithText   *bool    `json:"confirmEscapeWithText,omitempty"`
-	StatusMessageDurationMs *int     `json:"statusMessageDurationMs,omitempty"`
-	EscapeConfirmTimeoutMs  *int     `json:"escapeConfirmTimeoutMs,omitempty"`
+	SchemaVersion           int                      `json:"schemaVersion,omitempty"`
+	Questions               []string                 `json:"questions"`
+	ShowHints               *bool                    `json:"showHints,omitempty"`
+	AutoInsertEntries       *bool                    `json:"autoInsertEntries,omitempty"`
+	DefaultListMode         *bool                    `json:"defaultListMode,omitempty"`
+	AutoOpenIndexJump       *bool                    `json:"autoOpenIndexJump,omitempty"`
+	ConfirmDelete           *bool                    `json:"confirmDelete,omitempty"`
+	ContinueInsertAfterSave *bool                    `json:"continueInsertAfterSave,omitempty"`
+	ConfirmEscapeWithText   *bool                    `json:"confirmEscapeWithText,omitempty"`
+	StatusMessageDurationMs *int                     `json:"statusMessageDurationMs,omitempty"`
+	EscapeConfirmTimeoutMs  *int                     `json:"escapeConfirmTimeoutMs,omitempty"`
+	DefaultViewInterval     string                   `json:"defaultViewInterval,omitempty"`
+	DedupeEntries           *bool                    `json:"dedupeEntries,omitempty"`
+	EntrySoftLimitChars     *int                     `json:"entrySoftLimitChars,omitempty"`
+	SkipPlaceholder         string                   `json:"skipPlaceholder,omitempty"`
+	QuestionStyles          map[string]QuestionStyle `json:"questionStyles,omitempty"`
+	CarryQuestions          []string                 `json:"carryQuestions,omitempty"`
+	Editors                 []string                 `json:"editors,omitempty"`
+	CompactStorage          *bool                    `json:"compactStorage,omitempty"`
+	DisplayTimezone         string                   `json:"displayTimezone,omitempty"`
+	ShuffleQuestions        *bool                    `json:"shuffleQuestions,omitempty"`
+	StatusPosition          string                   `json:"statusPosition,omitempty"`
+	NoEntriesMessage        string                   `json:"noEntriesMessage,omitempty"`
+	WarnResponseLen         *int                     `json:"warnResponseLen,omitempty"`
+	TimestampPrecision      string                   `json:"timestampPrecision,omitempty"`
+	Locale                  string                   `json:"locale,omitempty"`
+	SilentEmpty             *bool                    `json:"silentEmpty,omitempty"`
+	SoftDelete              *bool                    `json:"softDelete,omitempty"`
+	AllowFutureEntries      *bool                    `json:"allowFutureEntries,omitempty"`
+	OrderedAnswers          *bool                    `json:"orderedAnswers,omitempty"`
+}
+
+// QuestionStyle customizes how a question is rendered in the TUI list.
+type QuestionStyle struct {
+	Color string `json:"color,omitempty"`
+	Icon  string `json:"icon,omitempty"`
+}
+
+// SchemaVersion is the newest day file and config format this build reads
+// and the one it writes. Files without a version are treated as version 1.
+const SchemaVersion = 1
+
+var ErrUnsupportedSchema = errors.New("unsupported schema version")
+
+func checkSchemaVersion(version int) error {
+	if version > SchemaVersion {
+		return fmt.Errorf("%w %d (this wlog supports up to %d), upgrade wlog to read it", ErrUnsupportedSchema, version, SchemaVersion)
+	}
+	return nil
+}
+
+func rawSchemaVersion(raw map[string]any) int {
+	version, _ := raw["schemaVersion"].(float64)
+	return int(version)
 }
 
 type DayLog struct {
-	Date    string              `json:"date"`
-	Answers map[string][]Answer `json:"answers"`
+	SchemaVersion int                 `json:"schemaVersion,omitempty"`
+	Date          string              `json:"date"`
+	Answers       map[string][]Answer `json:"answers"`
+	Mood          int                 `json:"mood,omitempty"`
+	Trash         []TrashedAnswer     `json:"trash,omitempty"`
+}
+
+// storedDayLog is DayLog with the answers object already encoded.
+type storedDayLog struct {
+	SchemaVersion int             `json:"schemaVersion,omitempty"`
+	Date          string          `json:"date"`
+	Answers       json.RawMessage `json:"answers"`
+	Mood          int             `json:"mood,omitempty"`
+	Trash         []TrashedAnswer `json:"trash,omitempty"`
+}
+
+// encodeDayLog renders log as a day file. Questions are keyed in the
+// settings' question order, then any others sorted, so saving the same day
+// with the same settings always produces the same bytes. With orderedAnswers
+// the answers are written in the list form instead.
+func encodeDayLog(log DayLog, settings Settings) ([]byte, error) {
+	if settings.orderedAnswers {
+		return marshalStored(log.Ordered(settings.questionOrder), settings.compactStorage)
+	}
+	answers, err := marshalAnswers(log.Answers, settings.questionOrder)
+	if err != nil {
+		return nil, err
+	}
+	return marshalStored(storedDayLog{
+		SchemaVersion: log.SchemaVersion,
+		Date:          log.Date,
+		Answers:       answers,
+		Mood:          log.Mood,
+		Trash:         log.Trash,
+	}, settings.compactStorage)
+}
+
+func marshalAnswers(answers map[string][]Answer, order []string) (json.RawMessage, error) {
+	if answers == nil {
+		return json.RawMessage("null"), nil
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, q := range OrderQuestions(answers, order) {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(q)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(answers[q])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
 }
 
 type Answer struct {
 	Time     string `json:"time"`
 	Response string `json:"response"`
+	Priority string `json:"priority,omitempty"`
+	Pinned   bool   `json:"pinned,omitempty"`
+}
+
+// PinnedOrder returns the indices of answers with pinned entries first,
+// keeping the stored order within the pinned and unpinned groups.
+func PinnedOrder(answers []Answer) []int {
+	order := make([]int, 0, len(answers))
+	for i, ans := range answers {
+		if ans.Pinned {
+			order = append(order, i)
+		}
+	}
+	for i, ans := range answers {
+		if !ans.Pinned {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+const (
+	PriorityHigh = "high"
+	PriorityLow  = "low"
+)
+
+func parsePriority(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case PriorityHigh:
+		return PriorityHigh, nil
+	case PriorityLow:
+		return PriorityLow, nil
+	case "", "normal":
+		return "", nil
+	default:
+		return "", fmt.Errorf("invalid priority %q, expected high, normal, or low", value)
+	}
 }
 
 func (cfg *Config) ensureDefaults() {
@@ -735,6 +1697,26 @@ func (cfg *Config) ensureDefaults() {
 	if cfg.EscapeConfirmTimeoutMs != nil && *cfg.EscapeConfirmTimeoutMs <= 0 {
 		cfg.EscapeConfirmTimeoutMs = nil
 	}
+	cfg.DefaultViewInterval = strings.TrimSpace(cfg.DefaultViewInterval)
+	cfg.SkipPlaceholder = strings.TrimSpace(cfg.SkipPlaceholder)
+	cfg.DisplayTimezone = strings.TrimSpace(cfg.DisplayTimezone)
+	cfg.StatusPosition = strings.ToLower(strings.TrimSpace(cfg.StatusPosition))
+	cfg.NoEntriesMessage = strings.TrimSpace(cfg.NoEntriesMessage)
+	cfg.TimestampPrecision = strings.ToLower(strings.TrimSpace(cfg.TimestampPrecision))
+	cfg.Locale = strings.TrimSpace(cfg.Locale)
+	var editors []string
+	for _, editor := range cfg.Editors {
+		if editor = strings.TrimSpace(editor); editor != "" {
+			editors = append(editors, editor)
+		}
+	}
+	cfg.Editors = editors
+	if cfg.EntrySoftLimitChars != nil && *cfg.EntrySoftLimitChars <= 0 {
+		cfg.EntrySoftLimitChars = nil
+	}
+	if cfg.WarnResponseLen != nil && *cfg.WarnResponseLen < 0 {
+		cfg.WarnResponseLen = nil
+	}
 }
 
 func (cfg Config) HintsEnabled() bool {
@@ -801,3 +1783,144 @@ func (cfg Config) EscapeConfirmTimeout() time.Duration {
 	}
 	return time.Duration(ms) * time.Millisecond
 }
+
+func (cfg Config) ViewInterval() string {
+	if cfg.DefaultViewInterval == "" {
+		return defaultViewInterval
+	}
+	return cfg.DefaultViewInterval
+}
+
+func (cfg Config) DedupeEntriesEnabled() bool {
+	if cfg.DedupeEntries == nil {
+		return defaultDedupeEntries
+	}
+	return *cfg.DedupeEntries
+}
+
+func (cfg Config) EntrySoftLimit() int {
+	if cfg.EntrySoftLimitChars == nil {
+		return defaultEntrySoftLimitChars
+	}
+	return *cfg.EntrySoftLimitChars
+}
+
+func (cfg Config) SkipAnswerPlaceholder() string {
+	if cfg.SkipPlaceholder == "" {
+		return defaultSkipPlaceholder
+	}
+	return cfg.SkipPlaceholder
+}
+
+func (cfg Config) QuestionStyle(question string) QuestionStyle {
+	return cfg.QuestionStyles[question]
+}
+
+func (cfg Config) CompactStorageEnabled() bool {
+	if cfg.CompactStorage == nil {
+		return defaultCompactStorage
+	}
+	return *cfg.CompactStorage
+}
+
+func (cfg Config) DisplayZone() string {
+	if cfg.DisplayTimezone == "" {
+		return defaultDisplayTimezone
+	}
+	return cfg.DisplayTimezone
+}
+
+func (cfg Config) ShuffleQuestionsEnabled() bool {
+	if cfg.ShuffleQuestions == nil {
+		return defaultShuffleQuestions
+	}
+	return *cfg.ShuffleQuestions
+}
+
+// Status positions accepted by the statusPosition option. Inline keeps the
+// status directly under the content; bottom pins it to the last terminal row.
+const (
+	StatusPositionInline = "inline"
+	StatusPositionBottom = "bottom"
+)
+
+func (cfg Config) StatusPlacement() string {
+	if cfg.StatusPosition != StatusPositionBottom {
+		return defaultStatusPosition
+	}
+	return cfg.StatusPosition
+}
+
+func (cfg Config) NoEntriesText() string {
+	if cfg.NoEntriesMessage == "" {
+		return defaultNoEntriesMessage
+	}
+	return cfg.NoEntriesMessage
+}
+
+// WarnResponseLength is the answer length past which RunPrompts notes how
+// long the answer was. It falls back to entrySoftLimitChars when unset; 0
+// turns the note off.
+func (cfg Config) WarnResponseLength() int {
+	if cfg.WarnResponseLen == nil {
+		return cfg.EntrySoftLimit()
+	}
+	return *cfg.WarnResponseLen
+}
+
+// Precisions accepted by the timestampPrecision option for new entries.
+const (
+	TimestampPrecisionSecond = "second"
+	TimestampPrecisionMinute = "minute"
+)
+
+func (cfg Config) TimestampResolution() string {
+	if cfg.TimestampPrecision != TimestampPrecisionMinute {
+		return defaultTimestampPrecision
+	}
+	return cfg.TimestampPrecision
+}
+
+func (cfg Config) LocaleName() string {
+	if cfg.Locale == "" {
+		return defaultLocale
+	}
+	return cfg.Locale
+}
+
+func (cfg Config) SilentEmptyEnabled() bool {
+	if cfg.SilentEmpty == nil {
+		return defaultSilentEmpty
+	}
+	return *cfg.SilentEmpty
+}
+
+func (cfg Config) SoftDeleteEnabled() bool {
+	if cfg.SoftDelete == nil {
+		return defaultSoftDelete
+	}
+	return *cfg.SoftDelete
+}
+
+func (cfg Config) FutureEntriesAllowed() bool {
+	if cfg.AllowFutureEntries == nil {
+		return defaultAllowFutureEntries
+	}
+	return *cfg.AllowFutureEntries
+}
+
+// CheckEntryDay refuses new entries on a day after today unless
+// allowFutureEntries is on, so planned days do not skew stats.
+func (cfg Config) CheckEntryDay(day time.Time) error {
+	if cfg.FutureEntriesAllowed() || !DayFloor(day).After(DayFloor(time.Now())) {
+		return nil
+	}
+	return fmt.Errorf("%s is in the future, set allowFutureEntries to log ahead", day.Format("2006-01-02"))
+}
+
+func (cfg Config) OrderedAnswersEnabled() bool {
+	if cfg.OrderedAnswers == nil {
+		return defaultOrderedAnswers
+	}
+	return *cfg.OrderedAnswers
+}
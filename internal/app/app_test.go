@@ -0,0 +1,244 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncodeDayLogFollowsQuestionOrder(t *testing.T) {
+	settings := Config{Questions: []string{"Second", "First"}}.Settings()
+	log := DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+		"First":  {{Time: "2026-03-02T09:00:00Z", Response: "one"}},
+		"Second": {{Time: "2026-03-02T10:00:00Z", Response: "two"}},
+		"Zeta":   {{Time: "2026-03-02T11:00:00Z", Response: "z"}},
+		"Alpha":  {{Time: "2026-03-02T12:00:00Z", Response: "a"}},
+	}}
+	data, err := encodeDayLog(log, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	want := []string{`"Second"`, `"First"`, `"Alpha"`, `"Zeta"`}
+	last := -1
+	for _, key := range want {
+		idx := strings.Index(got, key)
+		if idx <= last {
+			t.Fatalf("keys not in order %v:\n%s", want, got)
+		}
+		last = idx
+	}
+}
+
+func TestSaveDayLogIsByteStable(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	settings := Config{Questions: []string{"C", "A"}}.Settings()
+	answers := make(map[string][]Answer)
+	for _, q := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		answers[q] = []Answer{{Time: "2026-03-02T09:00:00Z", Response: q}}
+	}
+	log := DayLog{Answers: answers}
+
+	if err := SaveDayLog(day, log, settings); err != nil {
+		t.Fatal(err)
+	}
+	first := readDayFile(t, day)
+	for i := 0; i < 20; i++ {
+		reloaded, err := LoadDayLog(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := SaveDayLog(day, reloaded, settings); err != nil {
+			t.Fatal(err)
+		}
+		if again := readDayFile(t, day); again != first {
+			t.Fatalf("save %d differs:\n%s\nvs\n%s", i+2, first, again)
+		}
+	}
+}
+
+func TestEncodeDayLogDoesNotDependOnLoadedConfig(t *testing.T) {
+	useTempDirs(t)
+	log := DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+		"A": {{Time: "2026-03-02T09:00:00Z", Response: "a"}},
+		"B": {{Time: "2026-03-02T09:00:00Z", Response: "b"}},
+	}}
+	settings := Config{Questions: []string{"B", "A"}}.Settings()
+	before, err := encodeDayLog(log, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveConfig(Config{Questions: []string{"A", "B"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(); err != nil {
+		t.Fatal(err)
+	}
+	after, err := encodeDayLog(log, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Fatalf("encoding changed after loading a config:\n%s\nvs\n%s", before, after)
+	}
+}
+
+func TestParseIntervalFuture(t *testing.T) {
+	today := DayFloor(time.Now())
+	monday := StartOfWeek(today)
+	tests := []struct {
+		input      string
+		start, end time.Time
+	}{
+		{"tomorrow", today.AddDate(0, 0, 1), today.AddDate(0, 0, 1)},
+		{" Tomorrow ", today.AddDate(0, 0, 1), today.AddDate(0, 0, 1)},
+		{"this week", monday, today},
+		{"next week", monday.AddDate(0, 0, 7), monday.AddDate(0, 0, 13)},
+	}
+	for _, tt := range tests {
+		start, end, err := ParseInterval(tt.input)
+		if err != nil {
+			t.Fatalf("ParseInterval(%q): %v", tt.input, err)
+		}
+		if !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Errorf("ParseInterval(%q) = %s..%s, want %s..%s", tt.input, start.Format("2006-01-02"), end.Format("2006-01-02"), tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"))
+		}
+	}
+	start, end, _ := ParseInterval("next week")
+	if start.Weekday() != time.Monday || end.Weekday() != time.Sunday {
+		t.Errorf("next week runs %s to %s, want Monday to Sunday", start.Weekday(), end.Weekday())
+	}
+}
+
+func TestViewTomorrowShowsPlannedDay(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Plan?"}}); err != nil {
+		t.Fatal(err)
+	}
+	tomorrow := DayFloor(time.Now()).AddDate(0, 0, 1)
+	writeDay(t, tomorrow, dayWith(tomorrow, "Plan?", "ship the release"))
+	out, err := runOutput(t, "view", "tomorrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "ship the release") {
+		t.Fatalf("planned entry missing:\n%s", out)
+	}
+	if _, err := runOutput(t, "view", "next", "week"); err != nil && !errors.Is(err, ErrNoEntries) {
+		t.Fatal(err)
+	}
+}
+
+func TestRunQuestions(t *testing.T) {
+	out := captureStdout(t, func() { RunQuestions([]string{"Done?", "Doing?", "Blocked?", "Mood?"}) })
+	want := " 1  [0] Done?\n" +
+		" 2  [1] Doing?\n" +
+		" 3  [2] Blocked?\n" +
+		" 4  [3] Mood?\n"
+	if out != want {
+		t.Fatalf("questions output:\n%s\nwant:\n%s", out, want)
+	}
+	if out := captureStdout(t, func() { RunQuestions(nil) }); out != "No questions configured.\n" {
+		t.Fatalf("empty output = %q", out)
+	}
+}
+
+func TestCompactStorage(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "one", "two")
+	log.Mood = 3
+	compact := Config{Questions: []string{"Q"}, CompactStorage: boolPtr(true)}.Settings()
+	if err := SaveDayLog(day, log, compact); err != nil {
+		t.Fatal(err)
+	}
+	data := readDayFile(t, day)
+	if strings.Contains(strings.TrimSpace(data), "\n") {
+		t.Fatalf("compact day file is indented:\n%s", data)
+	}
+	reloaded, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Mood != 3 || len(reloaded.Answers["Q"]) != 2 || reloaded.Answers["Q"][1].Response != "two" {
+		t.Fatalf("compact round trip lost data: %+v", reloaded)
+	}
+
+	pretty := Config{Questions: []string{"Q"}}.Settings()
+	if err := SaveDayLog(day, reloaded, pretty); err != nil {
+		t.Fatal(err)
+	}
+	if data := readDayFile(t, day); !strings.Contains(data, "\n  \"") {
+		t.Fatalf("switching compactStorage off did not re-save indented:\n%s", data)
+	}
+
+	if err := SaveConfig(Config{Questions: []string{"Q"}, CompactStorage: boolPtr(true)}); err != nil {
+		t.Fatal(err)
+	}
+	path, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfgData, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.TrimSpace(string(cfgData)), "\n") {
+		t.Fatalf("compact config is indented:\n%s", cfgData)
+	}
+	cfg, err := LoadConfig()
+	if err != nil || !cfg.Settings().compactStorage {
+		t.Fatalf("compact config did not load back: %+v, %v", cfg, err)
+	}
+}
+
+func TestSchemaVersionOnLoad(t *testing.T) {
+	dataDir := useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	answers := `"answers":{"Q":[{"time":"2026-03-02T09:00:00Z","response":"a"}]}`
+	tests := []struct {
+		name    string
+		version string
+		ok      bool
+	}{
+		{"current", fmt.Sprintf(`"schemaVersion":%d,`, SchemaVersion), true},
+		{"missing", "", true},
+		{"too new", fmt.Sprintf(`"schemaVersion":%d,`, SchemaVersion+1), false},
+	}
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		writeFile(t, filepath.Join(dataDir, "2026-03-02.json"), `{`+tt.version+`"date":"2026-03-02",`+answers+`}`)
+		log, err := LoadDayLog(day)
+		if tt.ok && (err != nil || len(log.Answers["Q"]) != 1) {
+			t.Errorf("%s day file: %+v, %v", tt.name, log, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrUnsupportedSchema) {
+			t.Errorf("%s day file: err = %v, want ErrUnsupportedSchema", tt.name, err)
+		}
+
+		writeFile(t, cfgPath, `{`+tt.version+`"questions":["Q"]}`)
+		cfg, err := LoadConfig()
+		if tt.ok && (err != nil || len(cfg.Questions) != 1) {
+			t.Errorf("%s config: %+v, %v", tt.name, cfg, err)
+		}
+		if !tt.ok && (!errors.Is(err, ErrUnsupportedSchema) || ExitCode(err) != ExitConfig) {
+			t.Errorf("%s config: err = %v, want ErrUnsupportedSchema", tt.name, err)
+		}
+	}
+
+	if err := SaveDayLog(day, DayLog{Answers: map[string][]Answer{"Q": nil}}, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+	if data := readDayFile(t, day); !strings.Contains(data, fmt.Sprintf(`"schemaVersion": %d`, SchemaVersion)) {
+		t.Fatalf("saved day file has no schemaVersion:\n%s", data)
+	}
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// archiveDirName is the DataDir subdirectory old day files are moved into.
+// ListDayFiles skips directories, so archived days drop out of views unless
+// --include-archived is given. Commands that write a day find its archived
+// copy through DayFilePath and update it in place.
+const archiveDirName = "archive"
+
+var olderThanPattern = regexp.MustCompile(`^(\d+)\s*(d|days?)?$`)
+
+func ArchiveDir() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, archiveDirName), nil
+}
+
+func RunArchive(args []string, settings Settings, dryRun bool) error {
+	days := -1
+	for i := 0; i < len(args); i++ {
+		name, _, _ := strings.Cut(args[i], "=")
+		if name != "--older-than" {
+			return fmt.Errorf("unknown archive argument %q", args[i])
+		}
+		value, err := flagValue(args, &i)
+		if err != nil {
+			return err
+		}
+		if days, err = parseOlderThan(value); err != nil {
+			return err
+		}
+	}
+	if days < 0 {
+		return fmt.Errorf("missing cutoff, expected `wlog archive --older-than \"90 days\"`")
+	}
+
+	cutoff := archiveCutoff(time.Now(), days)
+	paths, err := dayFilesBefore(cutoff)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		fmt.Printf("No day files older than %s.\n", cutoff.Format("2006-01-02"))
+		return nil
+	}
+	archive, err := ArchiveDir()
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		printPlannedMove(paths, archive)
+		return nil
+	}
+	if err := EnsureDir(archive); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		dest := filepath.Join(archive, filepath.Base(path))
+		if _, err := os.Stat(dest); err == nil {
+			if err := mergeIntoArchive(path, dest, settings); err != nil {
+				return err
+			}
+			continue
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err := os.Rename(path, dest); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Archived %d day %s to %s.\n", len(paths), pluralize(len(paths), "file", "files"), archive)
+	return nil
+}
+
+// parseOlderThan accepts an age in days as "90 days", "90d" or "90".
+func parseOlderThan(value string) (int, error) {
+	matches := olderThanPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid --older-than value %q, expected a number of days like \"90 days\"", value)
+	}
+	days, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid --older-than value %q: %w", value, err)
+	}
+	return days, nil
+}
+
+// archiveCutoff returns the first day that is kept: files dated before it are
+// more than days old relative to now.
+func archiveCutoff(now time.Time, days int) time.Time {
+	return DayFloor(now).AddDate(0, 0, -days)
+}
+
+func dayFilesBefore(cutoff time.Time) ([]string, error) {
+	paths, err := ListDayFiles()
+	if err != nil {
+		return nil, err
+	}
+	var old []string
+	for _, path := range paths {
+		if day, ok := dayFromFileName(filepath.Base(path)); ok && day.Before(cutoff) {
+			old = append(old, path)
+		}
+	}
+	return old, nil
+}
+
+// mergeIntoArchive folds the day file at path into its archived copy at dest
+// and removes it. Such a file is left behind by builds that started a new day
+// file instead of updating the archived one.
+func mergeIntoArchive(path, dest string, settings Settings) error {
+	day, _ := dayFromFileName(filepath.Base(path))
+	current, err := readDayLogFile(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	archived, err := readDayLogFile(dest)
+	if err != nil {
+		return fmt.Errorf("%s: %w", dest, err)
+	}
+	mergeDayLog(&archived, current)
+	if err := writeDayLogFile(dest, day, archived, settings); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// mergeDayLog adds the answers of from that are missing from into, in time
+// order, along with its trash. A mood set in from replaces the one in into.
+func mergeDayLog(into *DayLog, from DayLog) {
+	for q, answers := range from.Answers {
+		for _, ans := range answers {
+			if !containsAnswer(into.Answers[q], ans) {
+				into.Answers[q] = insertByTime(into.Answers[q], ans)
+			}
+		}
+	}
+	into.Trash = append(into.Trash, from.Trash...)
+	if from.Mood != 0 {
+		into.Mood = from.Mood
+	}
+}
+
+func containsAnswer(answers []Answer, ans Answer) bool {
+	for _, existing := range answers {
+		if existing == ans {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseOlderThan(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+		ok    bool
+	}{
+		{"90 days", 90, true},
+		{"90d", 90, true},
+		{"90", 90, true},
+		{"1 day", 1, true},
+		{" 7 Days ", 7, true},
+		{"0", 0, true},
+		{"-5", 0, false},
+		{"3 weeks", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := parseOlderThan(tt.value)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("parseOlderThan(%q) = %d, %v; want %d, ok %v", tt.value, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestArchiveCutoff(t *testing.T) {
+	now := time.Date(2026, 3, 31, 18, 45, 0, 0, time.Local)
+	if got, want := archiveCutoff(now, 30), time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local); !got.Equal(want) {
+		t.Errorf("archiveCutoff(30) = %s, want %s", got, want)
+	}
+	if got, want := archiveCutoff(now, 0), DayFloor(now); !got.Equal(want) {
+		t.Errorf("archiveCutoff(0) = %s, want %s", got, want)
+	}
+}
+
+func TestRunArchiveMovesOldFiles(t *testing.T) {
+	dataDir := useTempDirs(t)
+	today := DayFloor(time.Now())
+	old := today.AddDate(0, 0, -40)
+	recent := today.AddDate(0, 0, -5)
+	writeDay(t, old, DayLog{Answers: map[string][]Answer{"Q": {{Time: old.Format(time.RFC3339), Response: "old"}}}})
+	writeDay(t, recent, DayLog{Answers: map[string][]Answer{"Q": {{Time: recent.Format(time.RFC3339), Response: "recent"}}}})
+
+	captureStdout(t, func() {
+		if err := RunArchive([]string{"--older-than", "30 days"}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dataDir, archiveDirName, dayFileName(old))); err != nil {
+		t.Errorf("old day not archived: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, dayFileName(old))); err == nil {
+		t.Error("old day left in the data directory")
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, dayFileName(recent))); err != nil {
+		t.Errorf("recent day moved: %v", err)
+	}
+}
+
+func TestWritersUpdateArchivedDay(t *testing.T) {
+	dataDir := useTempDirs(t)
+	day := DayFloor(time.Now()).AddDate(0, 0, -40)
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{"Q": {{Time: day.Add(9 * time.Hour).Format(time.RFC3339), Response: "before"}}}})
+	captureStdout(t, func() {
+		if err := RunArchive([]string{"--older-than=30"}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	archived := filepath.Join(dataDir, archiveDirName, dayFileName(day))
+
+	captureStdout(t, func() {
+		if err := RunAdd([]string{"--question-text", "Q", "--date", day.Format("2006-01-02"), "--time", "10:00", "after"}, Config{}, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := RunMood([]string{"4", day.Format("2006-01-02")}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dataDir, dayFileName(day))); err == nil {
+		t.Fatal("writers started a new day file that hides the archived one")
+	}
+	log, err := readDayLogFile(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers["Q"]) != 2 || log.Mood != 4 {
+		t.Fatalf("archived day not updated: %+v", log)
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunView(ViewOptions{Interval: "last 60 days"}, []string{"Q"}); err == nil {
+			t.Fatal("view without --include-archived showed the archived day")
+		}
+	})
+	if strings.Contains(out, "before") {
+		t.Fatalf("archived day shown without --include-archived:\n%s", out)
+	}
+	out = captureStdout(t, func() {
+		if err := RunView(ViewOptions{Interval: "last 60 days", IncludeArchived: true}, []string{"Q"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "before") || !strings.Contains(out, "after") {
+		t.Fatalf("--include-archived view lost entries:\n%s", out)
+	}
+}
+
+func TestRunArchiveMergesLeftoverDayFile(t *testing.T) {
+	dataDir := useTempDirs(t)
+	day := DayFloor(time.Now()).AddDate(0, 0, -40)
+	shared := Answer{Time: day.Add(9 * time.Hour).Format(time.RFC3339), Response: "shared"}
+	writeFile(t, filepath.Join(dataDir, archiveDirName, dayFileName(day)), `{"date":"`+day.Format("2006-01-02")+`","answers":{"Q":[{"time":"`+shared.Time+`","response":"shared"}]}}`)
+	// A day file written next to the archived copy by an older build.
+	leftover := DayLog{Mood: 3, Answers: map[string][]Answer{"Q": {shared, {Time: day.Add(10 * time.Hour).Format(time.RFC3339), Response: "later"}}}}
+	if err := writeDayLogFile(filepath.Join(dataDir, dayFileName(day)), day, leftover, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+
+	captureStdout(t, func() {
+		if err := RunArchive([]string{"--older-than", "30"}, Settings{}, false); err != nil {
+			t.Fatalf("re-archiving failed: %v", err)
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dataDir, dayFileName(day))); err == nil {
+		t.Fatal("leftover day file not removed")
+	}
+	log, err := readDayLogFile(filepath.Join(dataDir, archiveDirName, dayFileName(day)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ans := range log.Answers["Q"] {
+		got = append(got, ans.Response)
+	}
+	if strings.Join(got, ",") != "shared,later" || log.Mood != 3 {
+		t.Fatalf("merged day = %v mood %d, want shared,later mood 3", got, log.Mood)
+	}
+}
+
+func TestRenameAndRmCoverArchivedDays(t *testing.T) {
+	dataDir := useTempDirs(t)
+	day := DayFloor(time.Now()).AddDate(0, 0, -40)
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{"Old?": {{Time: day.Add(9 * time.Hour).Format(time.RFC3339), Response: "x"}}}})
+	captureStdout(t, func() {
+		if err := RunArchive([]string{"--older-than", "30"}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := RunRenameQuestion([]string{"Old?", "New?"}, Config{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	archived := filepath.Join(dataDir, archiveDirName, dayFileName(day))
+	log, err := readDayLogFile(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers["New?"]) != 1 || len(log.Answers["Old?"]) != 0 {
+		t.Fatalf("rename-question skipped the archived day: %+v", log.Answers)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, dayFileName(day))); err == nil {
+		t.Fatal("rename-question wrote a new day file")
+	}
+
+	captureStdout(t, func() {
+		if err := RunRm([]string{"--force", day.Format("2006-01-02")}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if _, err := os.Stat(archived); err == nil {
+		t.Fatal("rm left the archived day")
+	}
+}
+
+func TestGrepHintsAtArchivedDays(t *testing.T) {
+	useTempDirs(t)
+	day := DayFloor(time.Now()).AddDate(0, 0, -40)
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{"Q": {{Time: day.Add(9 * time.Hour).Format(time.RFC3339), Response: "needle"}}}})
+	captureStdout(t, func() {
+		if err := RunArchive([]string{"--older-than", "30"}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	out := captureStdout(t, func() {
+		if err := RunGrep([]string{"needle"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "--include-archived") {
+		t.Fatalf("no hint about archived days:\n%s", out)
+	}
+	out = captureStdout(t, func() {
+		if err := RunGrep([]string{"--include-archived", "needle"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "needle") || strings.Contains(out, "No matches") {
+		t.Fatalf("--include-archived missed the archived day:\n%s", out)
+	}
+}
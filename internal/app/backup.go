@@ -0,0 +1,154 @@
+package app
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	backupDataPrefix   = "data/"
+	backupConfigPrefix = "config/"
+)
+
+func RunBackup(args []string, dryRun bool) error {
+	if len(args) > 1 {
+		return fmt.Errorf("too many arguments, expected `wlog backup [dest]`")
+	}
+	dest := "."
+	if len(args) == 1 {
+		dest = args[0]
+	}
+	dest = filepath.Join(dest, fmt.Sprintf("wlog-backup-%s.zip", time.Now().Format("20060102-150405")))
+
+	dir, err := DataDir()
+	if err != nil {
+		return err
+	}
+	configPath, err := ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	files, err := backupFiles(dir, configPath)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		fmt.Printf("Dry run: would write %d %s to %s\n", len(files), pluralize(len(files), "file", "files"), dest)
+		return nil
+	}
+	if err := writeBackup(dest, files); err != nil {
+		return err
+	}
+	fmt.Printf("Backed up %d %s to %s\n", len(files), pluralize(len(files), "file", "files"), dest)
+	return nil
+}
+
+// backupFiles maps archive names to source paths: every day file in dir and
+// its archive/ subdirectory keeps its relative path below data/, and the
+// config file, when present, is stored below config/ under its own file
+// name. Anything else in dir, such as state.json, is left out so that every
+// backup can be restored.
+func backupFiles(dir, configPath string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && p == dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel != "." && rel != archiveDirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Type().IsRegular() && isBackupDayFile(rel) {
+			files[backupDataPrefix+rel] = p
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(configPath); err == nil {
+		files[backupConfigPrefix+filepath.Base(configPath)] = configPath
+	}
+	return files, nil
+}
+
+// isBackupDayFile reports whether rel, a slash-separated path below the data
+// directory, is a day file that backup writes and restore accepts: a
+// YYYY-MM-DD.json at the top level or in archive/.
+func isBackupDayFile(rel string) bool {
+	name := strings.TrimPrefix(rel, archiveDirName+"/")
+	_, ok := dayFromFileName(name)
+	return ok && !strings.Contains(name, "/")
+}
+
+func writeBackup(dest string, files map[string]string) (err error) {
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(dest)
+		}
+	}()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(out)
+	for _, name := range names {
+		if err := addZipFile(zw, name, files[name]); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func addZipFile(zw *zip.Writer, name, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = path.Clean(name)
+	header.Method = zip.Deflate
+	w, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, in)
+	return err
+}
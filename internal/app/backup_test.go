@@ -0,0 +1,184 @@
+package app
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	dataDir := useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	writeDay(t, mustDay(t, "2026-03-02"), DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-02T09:00:00Z", Response: "live"}}}})
+	archived := filepath.Join(dataDir, archiveDirName, "2025-01-05.json")
+	writeFile(t, archived, `{"date":"2025-01-05","answers":{"Q":[{"time":"2025-01-05T09:00:00Z","response":"old"}]}}`)
+	writeFile(t, filepath.Join(dataDir, "state.json"), `{"lastViewed":"2026-03-02T09:00:00Z"}`)
+	writeFile(t, filepath.Join(dataDir, ".DS_Store"), "junk")
+	writeFile(t, filepath.Join(dataDir, ".git", "HEAD"), "ref: refs/heads/main\n")
+	writeFile(t, filepath.Join(dataDir, "notes", "2026-03-01.json"), `{}`)
+
+	dest := t.TempDir()
+	captureStdout(t, func() {
+		if err := RunBackup([]string{dest}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	zips, _ := filepath.Glob(filepath.Join(dest, "wlog-backup-*.zip"))
+	if len(zips) != 1 {
+		t.Fatalf("expected one backup, got %v", zips)
+	}
+	want := []string{"config/config.json", "data/2026-03-02.json", "data/archive/2025-01-05.json"}
+	if got := zipNames(t, zips[0]); !reflect.DeepEqual(got, want) {
+		t.Fatalf("backup entries = %v, want %v", got, want)
+	}
+
+	configPath, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(dataDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(configPath); err != nil {
+		t.Fatal(err)
+	}
+	captureStdout(t, func() {
+		if err := RunRestore([]string{zips[0]}, false); err != nil {
+			t.Fatalf("restore of a fresh backup failed: %v", err)
+		}
+	})
+	for _, path := range []string{configPath, filepath.Join(dataDir, "2026-03-02.json"), archived} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("not restored: %v", err)
+		}
+	}
+	log, err := readDayLogFile(archived)
+	if err != nil || log.Answers["Q"][0].Response != "old" {
+		t.Fatalf("archived day not restored intact: %+v, %v", log, err)
+	}
+}
+
+func TestRestoreSkipsUnknownEntries(t *testing.T) {
+	dataDir := useTempDirs(t)
+	src := filepath.Join(t.TempDir(), "old.zip")
+	writeZip(t, src, map[string]string{
+		"data/2026-03-02.json": `{"date":"2026-03-02","answers":{}}`,
+		"data/state.json":      `{"lastViewed":"2026-03-02T09:00:00Z"}`,
+		"data/.git/HEAD":       "ref: refs/heads/main\n",
+		"notes.txt":            "hello",
+	})
+	captureStdout(t, func() {
+		if err := RunRestore([]string{src}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dataDir, "2026-03-02.json")); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"state.json", ".git"} {
+		if _, err := os.Stat(filepath.Join(dataDir, name)); err == nil {
+			t.Errorf("restored unknown entry %s", name)
+		}
+	}
+}
+
+func TestRestoreRejectsInvalidDayFile(t *testing.T) {
+	dataDir := useTempDirs(t)
+	src := filepath.Join(t.TempDir(), "bad.zip")
+	writeZip(t, src, map[string]string{
+		"data/2026-03-01.json": `{"date":"2026-03-01","answers":{}}`,
+		"data/2026-03-02.json": `not json`,
+	})
+	if err := RunRestore([]string{src}, false); err == nil {
+		t.Fatal("expected an error for an invalid day file")
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "2026-03-01.json")); err == nil {
+		t.Fatal("restore wrote files before validating the whole archive")
+	}
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	zw := zip.NewWriter(out)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func zipNames(t *testing.T, path string) []string {
+	t.Helper()
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestRestoreRefusesToOverwrite(t *testing.T) {
+	dataDir := useTempDirs(t)
+	src := filepath.Join(t.TempDir(), "backup.zip")
+	writeZip(t, src, map[string]string{
+		"data/2026-03-01.json": `{"date":"2026-03-01","answers":{"Q":[{"time":"2026-03-01T09:00:00Z","response":"backup"}]}}`,
+		"data/2026-03-02.json": `{"date":"2026-03-02","answers":{"Q":[{"time":"2026-03-02T09:00:00Z","response":"backup"}]}}`,
+	})
+	existing := filepath.Join(dataDir, "2026-03-02.json")
+	current := `{"date":"2026-03-02","answers":{"Q":[{"time":"2026-03-02T10:00:00Z","response":"current"}]}}`
+	writeFile(t, existing, current)
+
+	err := RunRestore([]string{src}, false)
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite 1 existing file") || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("error = %v, want a refusal naming --force", err)
+	}
+	if data, _ := os.ReadFile(existing); string(data) != current {
+		t.Fatal("existing day file changed")
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "2026-03-01.json")); err == nil {
+		t.Fatal("restore wrote other files after refusing")
+	}
+
+	captureStdout(t, func() {
+		if err := RunRestore([]string{"--force", src}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	log, err := readDayLogFile(existing)
+	if err != nil || log.Answers["Q"][0].Response != "backup" {
+		t.Fatalf("--force did not replace the day file: %+v, %v", log, err)
+	}
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+func RunCarry(args []string, cfg Config, dryRun bool) error {
+	if len(args) > 1 {
+		return fmt.Errorf("too many arguments, expected `wlog carry [date]`")
+	}
+	if len(cfg.CarryQuestions) == 0 {
+		return newConfigError(errors.New("no carry questions configured, add them to carryQuestions in the config"))
+	}
+	questions := make([]string, 0, len(cfg.CarryQuestions))
+	for _, selector := range cfg.CarryQuestions {
+		q, err := resolveQuestion(selector, cfg.Questions)
+		if err != nil {
+			return newConfigError(fmt.Errorf("carryQuestions: %w", err))
+		}
+		questions = append(questions, q)
+	}
+
+	day := DayFloor(time.Now())
+	if len(args) == 1 {
+		var err error
+		if day, err = parseAddDate(args[0]); err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD or a single day like \"yesterday\"", args[0])
+		}
+	}
+	if err := cfg.CheckEntryDay(day); err != nil {
+		return err
+	}
+	previous := day.AddDate(0, 0, -1)
+	from, err := LoadDayLog(previous)
+	if err != nil {
+		return err
+	}
+	to, err := LoadDayLog(day)
+	if err != nil {
+		return err
+	}
+
+	settings := cfg.Settings()
+	added := carryEntries(from, &to, questions, settings.EntryTimestamp(time.Now()))
+	if len(added) == 0 {
+		fmt.Printf("Nothing to carry from %s.\n", previous.Format("2006-01-02"))
+		return nil
+	}
+	if dryRun {
+		path, err := DayFilePath(day)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, added, settings)
+		return nil
+	}
+	if err := SaveDayLog(day, to, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Carried %d %s from %s to %s.\n", len(added), pluralize(len(added), "entry", "entries"), previous.Format("2006-01-02"), day.Format("2006-01-02"))
+	return nil
+}
+
+// carryEntries appends from's answers to questions onto to as new entries
+// stamped with timestamp. Responses to already holds are skipped, so carrying
+// twice is a no-op.
+func carryEntries(from DayLog, to *DayLog, questions []string, timestamp string) []plannedAnswer {
+	if to.Answers == nil {
+		to.Answers = make(map[string][]Answer)
+	}
+	var added []plannedAnswer
+	for _, q := range questions {
+		for _, ans := range from.Answers[q] {
+			if HasResponse(to.Answers[q], ans.Response) {
+				continue
+			}
+			carried := Answer{Time: timestamp, Response: ans.Response, Priority: ans.Priority}
+			to.Answers[q] = append(to.Answers[q], carried)
+			added = append(added, plannedAnswer{Question: q, Answer: carried})
+		}
+	}
+	return added
+}
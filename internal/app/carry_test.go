@@ -0,0 +1,101 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunCarryIsIdempotent(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Done?", "Plan?"}, CarryQuestions: []string{"2"}}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+	yesterday := mustDay(t, "2026-03-02")
+	log := dayWith(yesterday, "Plan?", "write tests", "review PR")
+	log.Answers["Done?"] = []Answer{{Time: "2026-03-02T17:00:00Z", Response: "shipped"}}
+	writeDay(t, yesterday, log)
+	today := yesterday.AddDate(0, 0, 1)
+
+	responses := func() []string {
+		t.Helper()
+		log, err := LoadDayLog(today)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(log.Answers["Done?"]) != 0 {
+			t.Fatalf("carried a question that is not in carryQuestions: %+v", log.Answers)
+		}
+		var got []string
+		for _, ans := range log.Answers["Plan?"] {
+			got = append(got, ans.Response)
+		}
+		return got
+	}
+
+	out, err := runOutput(t, "carry", "2026-03-03")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "Carried 2 entries from 2026-03-02 to 2026-03-03.\n" {
+		t.Fatalf("first carry output = %q", out)
+	}
+	want := []string{"write tests", "review PR"}
+	if got := responses(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("carried = %v, want %v", got, want)
+	}
+
+	out, err = runOutput(t, "carry", "2026-03-03")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "Nothing to carry from 2026-03-02.\n" {
+		t.Fatalf("second carry output = %q", out)
+	}
+	if got := responses(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("second carry changed the day: %v", got)
+	}
+}
+
+func TestRunCarryNeedsCarryQuestions(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Plan?"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runOutput(t, "carry"); ExitCode(err) != ExitConfig {
+		t.Fatalf("carry without carryQuestions: %v", err)
+	}
+}
+
+func TestRunCarryRefusesFutureDay(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Plan?"}, CarryQuestions: []string{"1"}}
+	today := DayFloor(time.Now())
+	writeDay(t, today, dayWith(today, "Plan?", "write tests"))
+	tomorrow := today.AddDate(0, 0, 1)
+	date := tomorrow.Format("2006-01-02")
+
+	err := RunCarry([]string{date}, cfg, false)
+	if err == nil || !strings.Contains(err.Error(), "allowFutureEntries") {
+		t.Fatalf("future carry: err = %v, want a refusal naming allowFutureEntries", err)
+	}
+	if log, _ := ReadDayLogIfExists(tomorrow); log != nil {
+		t.Fatal("refused carry wrote a day file")
+	}
+
+	cfg.AllowFutureEntries = boolPtr(true)
+	captureStdout(t, func() {
+		if err := RunCarry([]string{date}, cfg, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	log, err := LoadDayLog(tomorrow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers["Plan?"]) != 1 {
+		t.Fatalf("allowed carry = %+v", log.Answers)
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+type gitRunner interface {
+	Run(dir string, args ...string) (string, error)
+}
+
+type execGit struct{}
+
+func (execGit) Run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+var git gitRunner = execGit{}
+
+var errNotGitRepo = errors.New("data directory is not inside a git work tree")
+
+func RunCommit(args []string, dryRun bool) error {
+	dir, err := DataDir()
+	if err != nil {
+		return err
+	}
+	message := strings.TrimSpace(strings.Join(args, " "))
+	if message == "" {
+		message = fmt.Sprintf("wlog: update for %s", DayFloor(time.Now()).Format("2006-01-02"))
+	}
+	committed, err := commitDataDir(dir, message, dryRun)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !committed:
+		fmt.Println("Nothing to commit.")
+	case dryRun:
+		fmt.Printf("Dry run: would run `git add -A` and `git commit -m %q` in %s\n", message, dir)
+	default:
+		fmt.Printf("Committed: %s\n", message)
+	}
+	return nil
+}
+
+// commitDataDir stages and commits the changes under dir, leaving the rest of
+// the surrounding work tree alone. It reports false without committing when
+// dir has no changes.
+func commitDataDir(dir, message string, dryRun bool) (bool, error) {
+	inside, err := git.Run(dir, "rev-parse", "--is-inside-work-tree")
+	if err != nil || inside != "true" {
+		return false, errNotGitRepo
+	}
+	status, err := git.Run(dir, "status", "--porcelain", "--", ".")
+	if err != nil {
+		return false, err
+	}
+	if status == "" {
+		return false, nil
+	}
+	if dryRun {
+		return true, nil
+	}
+	if _, err := git.Run(dir, "add", "-A", "--", "."); err != nil {
+		return false, err
+	}
+	if _, err := git.Run(dir, "commit", "-m", message, "--", "."); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeGit answers git commands from a table keyed by the first argument and
+// records every call.
+type fakeGit struct {
+	outputs map[string]string
+	fail    map[string]bool
+	calls   []string
+}
+
+func (g *fakeGit) Run(dir string, args ...string) (string, error) {
+	g.calls = append(g.calls, strings.Join(args, " "))
+	if g.fail[args[0]] {
+		return "", errors.New("git " + args[0] + ": fatal")
+	}
+	return g.outputs[args[0]], nil
+}
+
+func useFakeGit(t *testing.T, g *fakeGit) {
+	t.Helper()
+	orig := git
+	git = g
+	t.Cleanup(func() { git = orig })
+}
+
+func TestRunCommitInRepo(t *testing.T) {
+	useTempDirs(t)
+	g := &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": " M 2026-03-02.json"}}
+	useFakeGit(t, g)
+
+	out := captureStdout(t, func() {
+		if err := RunCommit(nil, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	message := "wlog: update for " + DayFloor(time.Now()).Format("2006-01-02")
+	if out != "Committed: "+message+"\n" {
+		t.Fatalf("output = %q", out)
+	}
+	want := []string{
+		"rev-parse --is-inside-work-tree",
+		"status --porcelain -- .",
+		"add -A -- .",
+		"commit -m " + message + " -- .",
+	}
+	if !reflect.DeepEqual(g.calls, want) {
+		t.Fatalf("git calls = %q, want %q", g.calls, want)
+	}
+}
+
+func TestRunCommitBranches(t *testing.T) {
+	tests := []struct {
+		name    string
+		git     *fakeGit
+		args    []string
+		dryRun  bool
+		out     string
+		err     error
+		commits bool
+	}{
+		{
+			name: "not a repo",
+			git:  &fakeGit{fail: map[string]bool{"rev-parse": true}},
+			err:  errNotGitRepo,
+		},
+		{
+			name: "bare repo",
+			git:  &fakeGit{outputs: map[string]string{"rev-parse": "false"}},
+			err:  errNotGitRepo,
+		},
+		{
+			name: "clean",
+			git:  &fakeGit{outputs: map[string]string{"rev-parse": "true"}},
+			out:  "Nothing to commit.\n",
+		},
+		{
+			name:   "dry run",
+			git:    &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": "?? new.json"}},
+			args:   []string{"sync", "notes"},
+			dryRun: true,
+			out:    "Dry run: would run `git add -A` and `git commit -m \"sync notes\"`",
+		},
+		{
+			name:    "custom message",
+			git:     &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": "?? new.json"}},
+			args:    []string{"sync", "notes"},
+			out:     "Committed: sync notes\n",
+			commits: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			useFakeGit(t, tt.git)
+			var err error
+			out := captureStdout(t, func() {
+				err = RunCommit(tt.args, tt.dryRun)
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if !strings.HasPrefix(out, tt.out) {
+				t.Fatalf("output = %q, want %q", out, tt.out)
+			}
+			committed := false
+			for _, call := range tt.git.calls {
+				if strings.HasPrefix(call, "commit") {
+					committed = true
+				}
+			}
+			if committed != tt.commits {
+				t.Fatalf("committed = %v, want %v (calls %q)", committed, tt.commits, tt.git.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+var completionCommands = []string{
+	"view",
+	"cat",
+	"add",
+	"mood",
+	"carry",
+	"trash",
+	"remind",
+	"digest",
+	"export",
+	"import",
+	"stats",
+	"last",
+	"words",
+	"dupes",
+	"commit",
+	"backup",
+	"restore",
+	"rm",
+	"prune",
+	"archive",
+	"ls",
+	"config",
+	"info",
+	"questions",
+	"rename-question",
+	"open",
+	"grep",
+	"schema",
+	"completion",
+	"help",
+	"version",
+}
+
+var completionIntervals = []string{
+	"today",
+	"yesterday",
+	"tomorrow",
+	"this week",
+	"last week",
+	"next week",
+	"this year",
+	"last 7 days",
+}
+
+var completionShells = []string{"bash", "zsh", "fish"}
+
+func RunCompletion(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing shell, expected one of: %s", strings.Join(completionShells, ", "))
+	}
+	script, err := CompletionScript(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Print(script)
+	return nil
+}
+
+func CompletionScript(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return bashCompletion(), nil
+	case "zsh":
+		return zshCompletion(), nil
+	case "fish":
+		return fishCompletion(), nil
+	default:
+		return "", fmt.Errorf("unsupported shell %q, expected one of: %s", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+func bashCompletion() string {
+	var b strings.Builder
+	b.WriteString("# bash completion for wlog\n")
+	b.WriteString("_wlog() {\n")
+	b.WriteString("  local cur prev\n")
+	b.WriteString("  cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	b.WriteString("  prev=\"${COMP_WORDS[1]}\"\n")
+	b.WriteString("  if [ \"$COMP_CWORD\" -eq 1 ]; then\n")
+	b.WriteString(fmt.Sprintf("    COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(completionCommands, " ")))
+	b.WriteString("    return\n")
+	b.WriteString("  fi\n")
+	b.WriteString("  case \"$prev\" in\n")
+	b.WriteString("    view|cat|digest|stats|words|dupes)\n")
+	b.WriteString("      local IFS=$'\\n'\n")
+	b.WriteString(fmt.Sprintf("      COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", bashQuotedLines(completionIntervals)))
+	b.WriteString("      ;;\n")
+	b.WriteString("    ls|open)\n")
+	b.WriteString("      COMPREPLY=($(compgen -W \"config\" -- \"$cur\"))\n")
+	b.WriteString("      ;;\n")
+	b.WriteString("    config)\n")
+	b.WriteString("      COMPREPLY=($(compgen -W \"edit clean\" -- \"$cur\"))\n")
+	b.WriteString("      ;;\n")
+	b.WriteString("    completion)\n")
+	b.WriteString(fmt.Sprintf("      COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(completionShells, " ")))
+	b.WriteString("      ;;\n")
+	b.WriteString("  esac\n")
+	b.WriteString("}\n")
+	b.WriteString("complete -F _wlog wlog\n")
+	return b.String()
+}
+
+func bashQuotedLines(values []string) string {
+	return "$'" + strings.Join(values, "\\n") + "'"
+}
+
+func zshCompletion() string {
+	var b strings.Builder
+	b.WriteString("#compdef wlog\n")
+	b.WriteString("_wlog() {\n")
+	b.WriteString("  local -a commands intervals shells\n")
+	b.WriteString(fmt.Sprintf("  commands=(%s)\n", strings.Join(completionCommands, " ")))
+	b.WriteString(fmt.Sprintf("  intervals=(%s)\n", zshQuoted(completionIntervals)))
+	b.WriteString(fmt.Sprintf("  shells=(%s)\n", strings.Join(completionShells, " ")))
+	b.WriteString("  if (( CURRENT == 2 )); then\n")
+	b.WriteString("    compadd -a commands\n")
+	b.WriteString("    return\n")
+	b.WriteString("  fi\n")
+	b.WriteString("  case \"$words[2]\" in\n")
+	b.WriteString("    view|cat|digest|stats|words|dupes) compadd -a intervals ;;\n")
+	b.WriteString("    ls|open) compadd config ;;\n")
+	b.WriteString("    config) compadd edit clean ;;\n")
+	b.WriteString("    completion) compadd -a shells ;;\n")
+	b.WriteString("  esac\n")
+	b.WriteString("}\n")
+	b.WriteString("compdef _wlog wlog\n")
+	return b.String()
+}
+
+func zshQuoted(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("'%s'", v))
+	}
+	return strings.Join(quoted, " ")
+}
+
+func fishCompletion() string {
+	var b strings.Builder
+	b.WriteString("# fish completion for wlog\n")
+	b.WriteString("complete -c wlog -f\n")
+	b.WriteString(fmt.Sprintf("complete -c wlog -n '__fish_use_subcommand' -a '%s'\n", strings.Join(completionCommands, " ")))
+	for _, interval := range completionIntervals {
+		b.WriteString(fmt.Sprintf("complete -c wlog -n '__fish_seen_subcommand_from view cat digest stats words dupes' -a %q\n", interval))
+	}
+	b.WriteString("complete -c wlog -n '__fish_seen_subcommand_from ls open' -a 'config'\n")
+	b.WriteString("complete -c wlog -n '__fish_seen_subcommand_from config' -a 'edit clean'\n")
+	b.WriteString(fmt.Sprintf("complete -c wlog -n '__fish_seen_subcommand_from completion' -a '%s'\n", strings.Join(completionShells, " ")))
+	return b.String()
+}
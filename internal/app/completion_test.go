@@ -0,0 +1,57 @@
+package app
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestBashCompletionListsCommands(t *testing.T) {
+	script, err := CompletionScript("bash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	match := regexp.MustCompile(`COMP_CWORD" -eq 1 \]; then\n    COMPREPLY=\(\$\(compgen -W "([^"]*)"`).FindStringSubmatch(script)
+	if match == nil {
+		t.Fatalf("no command list in the bash script:\n%s", script)
+	}
+	listed := make(map[string]bool)
+	for _, cmd := range strings.Fields(match[1]) {
+		listed[cmd] = true
+	}
+	for _, want := range []string{"view", "cat", "add", "grep", "rm", "backup", "restore", "schema", "completion", "version", "help"} {
+		if !listed[want] {
+			t.Errorf("bash completion is missing %q", want)
+		}
+	}
+
+	// Every command documented in the usage text completes.
+	for _, m := range regexp.MustCompile(`(?m)^  wlog ([a-z][a-z-]*)`).FindAllStringSubmatch(UsageText(), -1) {
+		if !listed[m[1]] {
+			t.Errorf("usage documents %q but bash completion does not list it", m[1])
+		}
+	}
+	if !strings.HasSuffix(script, "complete -F _wlog wlog\n") {
+		t.Error("bash script does not register the completion function")
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	for _, shell := range completionShells {
+		script, err := CompletionScript(shell)
+		if err != nil {
+			t.Fatalf("%s: %v", shell, err)
+		}
+		for _, want := range []string{"rename-question", "last 7 days", "zsh", "edit clean"} {
+			if !strings.Contains(script, want) {
+				t.Errorf("%s script is missing %q", shell, want)
+			}
+		}
+	}
+	if _, err := CompletionScript("tcsh"); err == nil {
+		t.Error("expected an error for an unsupported shell")
+	}
+	if err := RunCompletion(nil); err == nil {
+		t.Error("expected an error without a shell")
+	}
+}
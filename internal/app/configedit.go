@@ -0,0 +1,96 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ConfigEditor launches the interactive config editor. The app package cannot
+// import the TUI, so binaries that bundle it set this before calling Run.
+var ConfigEditor func() error
+
+func RunConfigCommand(args []string, dryRun bool) error {
+	if len(args) == 1 && args[0] == "clean" {
+		return RunConfigClean(dryRun)
+	}
+	if len(args) != 1 || args[0] != "edit" {
+		return fmt.Errorf("expected `wlog config edit` or `wlog config clean`")
+	}
+	if ConfigEditor == nil {
+		return fmt.Errorf("the config editor is not part of this build, edit the file from `wlog ls config` instead")
+	}
+	return ConfigEditor()
+}
+
+// RunConfigClean drops "_"-prefixed keys that are not default markers this
+// build knows about, then rewrites the config the way SaveConfig would so the
+// remaining markers match the current defaults.
+func RunConfigClean(dryRun bool) error {
+	path, err := ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	raw, err := readConfigMap(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Printf("No config file at %s.\n", path)
+		return nil
+	}
+	if err != nil {
+		return newConfigError(err)
+	}
+	if err := checkSchemaVersion(rawSchemaVersion(raw)); err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	removed := removeUnknownMarkers(raw)
+	cfg, err := configFromMap(raw)
+	if err != nil {
+		return newConfigError(err)
+	}
+	cfg.ensureDefaults()
+	applyConfigToMap(raw, cfg)
+	applyDefaultMarkers(raw)
+
+	if dryRun {
+		if len(removed) == 0 {
+			fmt.Printf("Dry run: no unknown markers in %s, would rewrite it normalized\n", path)
+			return nil
+		}
+		fmt.Printf("Dry run: would remove %d %s from %s\n", len(removed), pluralize(len(removed), "marker", "markers"), path)
+		for _, key := range removed {
+			fmt.Printf("  %s\n", key)
+		}
+		return nil
+	}
+	if err := writeConfigMap(path, raw); err != nil {
+		return err
+	}
+	if len(removed) == 0 {
+		fmt.Printf("No unknown markers in %s, rewrote it normalized.\n", path)
+		return nil
+	}
+	fmt.Printf("Removed %d unknown %s from %s: %s\n", len(removed), pluralize(len(removed), "marker", "markers"), path, strings.Join(removed, ", "))
+	return nil
+}
+
+// removeUnknownMarkers deletes every "_"-prefixed key of raw that is not in
+// defaultConfigMarkers and returns the deleted keys sorted.
+func removeUnknownMarkers(raw map[string]any) []string {
+	var removed []string
+	for key := range raw {
+		if !strings.HasPrefix(key, "_") {
+			continue
+		}
+		if _, ok := defaultConfigMarkers[key]; ok {
+			continue
+		}
+		delete(raw, key)
+		removed = append(removed, key)
+	}
+	sort.Strings(removed)
+	return removed
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConfigEditDispatch(t *testing.T) {
+	useTempDirs(t)
+	defer func(orig func() error) { ConfigEditor = orig }(ConfigEditor)
+
+	calls := 0
+	ConfigEditor = func() error {
+		calls++
+		return nil
+	}
+	if _, err := runOutput(t, "config", "edit"); err != nil || calls != 1 {
+		t.Fatalf("config edit: calls %d, err %v", calls, err)
+	}
+	if _, err := runOutput(t, "config", "bogus"); err == nil || calls != 1 {
+		t.Fatalf("config bogus: calls %d, err %v", calls, err)
+	}
+
+	failed := errors.New("editor failed")
+	ConfigEditor = func() error { return failed }
+	if _, err := runOutput(t, "config", "edit"); !errors.Is(err, failed) {
+		t.Fatalf("editor error not returned: %v", err)
+	}
+
+	ConfigEditor = nil
+	if _, err := runOutput(t, "config", "edit"); err == nil || !strings.Contains(err.Error(), "not part of this build") {
+		t.Fatalf("config edit without an editor: %v", err)
+	}
+}
+
+func TestConfigCleanRemovesUnknownMarkers(t *testing.T) {
+	useTempDirs(t)
+	path, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := captureStdout(t, func() { err = RunConfigClean(false) })
+	if err != nil || out != "No config file at "+path+".\n" {
+		t.Fatalf("clean without a config: %q, %v", out, err)
+	}
+
+	original := `{"questions":["Q"],"showHints":false,"_showHints":false,"_foo":1,"_bar":"x"}`
+	writeFile(t, path, original)
+	out = captureStdout(t, func() { err = RunConfigClean(true) })
+	if err != nil || !strings.Contains(out, "would remove 2 markers") || !strings.Contains(out, "  _bar\n  _foo\n") {
+		t.Fatalf("dry run: %q, %v", out, err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != original {
+		t.Fatalf("dry run rewrote the config:\n%s", data)
+	}
+
+	out, err = runOutput(t, "config", "clean")
+	if err != nil || out != "Removed 2 unknown markers from "+path+": _bar, _foo\n" {
+		t.Fatalf("clean: %q, %v", out, err)
+	}
+	raw, err := readConfigMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"_foo", "_bar"} {
+		if _, ok := raw[key]; ok {
+			t.Errorf("unknown marker %s kept", key)
+		}
+	}
+	for key, want := range defaultConfigMarkers {
+		if got, ok := raw[key]; !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("marker %s = %v, want %v", key, got, want)
+		}
+	}
+	if raw["showHints"] != false || !reflect.DeepEqual(raw["questions"], []any{"Q"}) {
+		t.Fatalf("clean changed settings: %v", raw)
+	}
+
+	out, err = runOutput(t, "config", "clean")
+	if err != nil || !strings.HasPrefix(out, "No unknown markers in ") {
+		t.Fatalf("second clean: %q, %v", out, err)
+	}
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderDayCount summarizes a day as its entry total followed by a count per
+// question in display order, each labeled with its short key.
+func renderDayCount(log DayLog, questions []string) string {
+	ordered := OrderQuestions(log.Answers, questions)
+	labels := shortQuestionKeys(ordered)
+	total := 0
+	var parts []string
+	for _, q := range ordered {
+		n := len(log.Answers[q])
+		if n == 0 {
+			continue
+		}
+		total += n
+		parts = append(parts, fmt.Sprintf("%s:%d", labels[q], n))
+	}
+	line := fmt.Sprintf("%s: %d %s", log.Date, total, pluralize(total, "entry", "entries"))
+	if len(parts) > 0 {
+		line += " (" + strings.Join(parts, " ") + ")"
+	}
+	return line + "\n"
+}
+
+// shortQuestionKeys labels each question with the fewest trailing words of
+// its questionKey that no other question ends with, so "What did you do
+// yesterday?" becomes "yesterday". A question whose words run out first
+// keeps its whole key.
+func shortQuestionKeys(questions []string) map[string]string {
+	words := make(map[string][]string, len(questions))
+	for _, q := range questions {
+		words[q] = questionWords(q)
+	}
+	suffix := func(w []string, n int) string {
+		return strings.Join(w[max(0, len(w)-n):], "-")
+	}
+	labels := make(map[string]string, len(questions))
+	for _, q := range questions {
+		labels[q] = questionKey(q)
+		for n := 1; n < len(words[q]); n++ {
+			label := suffix(words[q], n)
+			unique := true
+			for _, other := range questions {
+				if other != q && suffix(words[other], n) == label {
+					unique = false
+					break
+				}
+			}
+			if unique {
+				labels[q] = label
+				break
+			}
+		}
+	}
+	return labels
+}
+
+// viewTotals accumulates the days and entries printed by view and cat for
+// the footer shown under multi-day output.
+type viewTotals struct {
+	days    int
+	entries int
+}
+
+func (t *viewTotals) add(log DayLog) {
+	t.days++
+	for _, answers := range log.Answers {
+		t.entries += len(answers)
+	}
+}
+
+// footer returns "Total: N entries across D days", or "" when at most one
+// day was printed.
+func (t viewTotals) footer() string {
+	if t.days <= 1 {
+		return ""
+	}
+	return fmt.Sprintf("Total: %d %s across %d days\n", t.entries, pluralize(t.entries, "entry", "entries"), t.days)
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderDayCount(t *testing.T) {
+	questions := []string{"What did you do yesterday?", "What will/did you do today?", "Are you blocked with anything?"}
+	tests := []struct {
+		name string
+		log  DayLog
+		want string
+	}{
+		{
+			name: "configured and extra questions",
+			log: DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+				"What will/did you do today?":    {{Response: "a"}, {Response: "b"}, {Response: "c"}},
+				"What did you do yesterday?":     {{Response: "d"}, {Response: "e"}},
+				"Are you blocked with anything?": {},
+				"Side project?":                  {{Response: "f"}},
+			}},
+			want: "2026-03-02: 6 entries (yesterday:2 today:3 project:1)\n",
+		},
+		{
+			name: "single entry",
+			log:  DayLog{Date: "2026-03-03", Answers: map[string][]Answer{"Are you blocked with anything?": {{Response: "x"}}}},
+			want: "2026-03-03: 1 entry (anything:1)\n",
+		},
+		{
+			name: "empty day",
+			log:  DayLog{Date: "2026-03-04"},
+			want: "2026-03-04: 0 entries\n",
+		},
+	}
+	for _, tt := range tests {
+		if got := renderDayCount(tt.log, questions); got != tt.want {
+			t.Errorf("%s: renderDayCount = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestShortQuestionKeys(t *testing.T) {
+	got := shortQuestionKeys([]string{"What did you do today?", "What will you do today?", "Today?", "Mood (1-5)", "???"})
+	want := map[string]string{
+		"What did you do today?":  "did-you-do-today",
+		"What will you do today?": "will-you-do-today",
+		"Today?":                  "today",
+		"Mood (1-5)":              "5",
+		"???":                     "q",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("shortQuestionKeys = %v, want %v", got, want)
+	}
+}
+
+func TestViewCountOnly(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q0", "Q1"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	writeDay(t, today, dayWith(today, "Q1", "a", "b"))
+	out, err := runOutput(t, "view", "--count-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := today.Format("2006-01-02") + ": 2 entries (q1:2)\n"; out != want {
+		t.Fatalf("view --count-only = %q, want %q", out, want)
+	}
+}
+
+func TestViewFooterTotals(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	writeDay(t, today, dayWith(today, "Q", "a", "b", "c"))
+	yesterday := today.AddDate(0, 0, -1)
+	log := dayWith(yesterday, "Q", "d")
+	log.Answers["Extra"] = []Answer{{Time: yesterday.Add(12 * time.Hour).Format(time.RFC3339), Response: "e"}}
+	writeDay(t, yesterday, log)
+	twoDaysAgo := today.AddDate(0, 0, -2)
+	writeDay(t, twoDaysAgo, dayWith(twoDaysAgo, "Q", "f"))
+
+	for _, cmd := range []string{"view", "cat"} {
+		out, err := runOutput(t, cmd, "--days", "3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(out, "Total: 6 entries across 3 days\n") {
+			t.Fatalf("%s footer missing:\n%s", cmd, out)
+		}
+		out, err = runOutput(t, cmd, "today")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(out, "Total:") {
+			t.Fatalf("%s printed a footer for a single day:\n%s", cmd, out)
+		}
+	}
+	if got := (viewTotals{days: 2, entries: 1}).footer(); got != "Total: 1 entry across 2 days\n" {
+		t.Fatalf("footer = %q", got)
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+const digestWidth = 80
+
+func RunDigest(opts ViewOptions, questions []string) error {
+	start, end, err := opts.bounds()
+	if err != nil {
+		return err
+	}
+
+	printed := false
+	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
+		entry, err := opts.readDay(cursor)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			continue
+		}
+		summary := summarizeDay(opts.filterDayLog(*entry), questions)
+		if summary == "" {
+			continue
+		}
+		fmt.Printf("%s  %s\n", opts.Settings.FormatDayLabel(cursor), summary)
+		printed = true
+	}
+
+	if !printed {
+		fmt.Printf("No entries found for %s.\n", opts.label())
+	}
+	return nil
+}
+
+// summarizeDay joins every response of the day in question order and
+// truncates the result to digestWidth runes, ending in an ellipsis when cut.
+func summarizeDay(log DayLog, base []string) string {
+	var parts []string
+	for _, q := range OrderQuestions(log.Answers, base) {
+		for _, ans := range log.Answers[q] {
+			if response := strings.TrimSpace(ans.Response); response != "" {
+				parts = append(parts, response)
+			}
+		}
+	}
+	return truncateRunes(strings.Join(parts, "; "), digestWidth)
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		value string
+		limit int
+		want  string
+	}{
+		{"", 5, ""},
+		{"abcd", 5, "abcd"},
+		{"abcde", 5, "abcde"},
+		{"abcdef", 5, "abcd…"},
+		{"héllo wörld", 6, "héllo…"},
+		{"日本語テキスト", 4, "日本語…"},
+	}
+	for _, tt := range tests {
+		if got := truncateRunes(tt.value, tt.limit); got != tt.want {
+			t.Errorf("truncateRunes(%q, %d) = %q, want %q", tt.value, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeDay(t *testing.T) {
+	log := DayLog{Answers: map[string][]Answer{
+		"B": {{Response: "second"}},
+		"A": {{Response: " first "}, {Response: "  "}},
+	}}
+	if got := summarizeDay(log, []string{"A", "B"}); got != "first; second" {
+		t.Fatalf("summarizeDay = %q", got)
+	}
+
+	exact := strings.Repeat("x", digestWidth)
+	if got := summarizeDay(DayLog{Answers: map[string][]Answer{"A": {{Response: exact}}}}, nil); got != exact {
+		t.Fatalf("a summary of exactly %d runes was cut: %q", digestWidth, got)
+	}
+	long := summarizeDay(DayLog{Answers: map[string][]Answer{"A": {{Response: exact}, {Response: "more"}}}}, nil)
+	if len([]rune(long)) != digestWidth || !strings.HasSuffix(long, "…") {
+		t.Fatalf("long summary = %q (%d runes)", long, len([]rune(long)))
+	}
+}
+
+func TestDigestSkipsEmptyDays(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	first, empty, blank := mustDay(t, "2026-03-02"), mustDay(t, "2026-03-03"), mustDay(t, "2026-03-04")
+	writeDay(t, first, dayWith(first, "Q", "standup", "review"))
+	writeDay(t, empty, DayLog{Answers: map[string][]Answer{"Q": {}}})
+	writeDay(t, blank, dayWith(blank, "Q", "   "))
+
+	out, err := runOutput(t, "digest", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Mon 2026-03-02  standup; review\n"; out != want {
+		t.Fatalf("digest output = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"fmt"
+)
+
+type plannedAnswer struct {
+	Question string
+	Answer   Answer
+}
+
+func printPlannedWrite(path string, planned []plannedAnswer, settings Settings) {
+	fmt.Printf("Dry run: would write %d %s to %s\n", len(planned), pluralize(len(planned), "entry", "entries"), path)
+	for _, p := range planned {
+		fmt.Printf("  [%s] %s: %s\n", settings.DisplayTime(p.Answer.Time), p.Question, p.Answer.Response)
+	}
+}
+
+func printPlannedRemove(paths []string) {
+	fmt.Printf("Dry run: would remove %d %s\n", len(paths), pluralize(len(paths), "file", "files"))
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
+}
+
+func printPlannedMove(paths []string, dest string) {
+	fmt.Printf("Dry run: would move %d %s to %s\n", len(paths), pluralize(len(paths), "file", "files"), dest)
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// snapshotDir returns the contents of every file under dir by relative path.
+func snapshotDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files[rel] = string(data)
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestDryRunWritesNothing(t *testing.T) {
+	dataDir := useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Q", "existing"))
+
+	form := captureStdout(t, func() {
+		if err := RunExport([]string{"form", "2026-03-02"}, []string{"Q"}, Settings{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	formPath := filepath.Join(t.TempDir(), "form.md")
+	writeFile(t, formPath, strings.Replace(form, formBullet+" \n", formBullet+" [10:00] imported\n", 1))
+
+	before := snapshotDir(t, dataDir)
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"add", "--date", "2026-03-02", "1", "planned"}, "Dry run: would write 1 entry"},
+		{[]string{"import", "form", formPath}, "imported"},
+		{[]string{"mood", "4", "2026-03-02"}, "Dry run: would set mood 4/5"},
+		{[]string{"rm", "--force", "2026-03-02"}, "Dry run: would remove 1 file"},
+		{[]string{"archive", "--older-than", "0"}, "Dry run: would move 1 file"},
+	}
+	for _, tt := range tests {
+		out, err := runOutput(t, append([]string{"--dry-run"}, tt.args...)...)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%v output:\n%s\nwant %q", tt.args, out, tt.want)
+		}
+		if after := snapshotDir(t, dataDir); !reflect.DeepEqual(after, before) {
+			t.Fatalf("%v changed the data directory under --dry-run:\n%v\nvs\n%v", tt.args, before, after)
+		}
+	}
+}
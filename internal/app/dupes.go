@@ -0,0 +1,111 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// duplicateGroup is one response that was logged more than once under the
+// same question, with every occurrence in date order.
+type duplicateGroup struct {
+	Question    string
+	Response    string
+	Occurrences []datedAnswer
+}
+
+func RunDupes(opts ViewOptions, base []string) error {
+	logs, err := collectDayLogs(opts)
+	if err != nil {
+		return err
+	}
+	groups := findDuplicates(logs, base)
+	if len(groups) == 0 {
+		fmt.Printf("No duplicate entries found for %s.\n", opts.label())
+		return nil
+	}
+	fmt.Print(renderDuplicates(groups, opts.Settings))
+	return nil
+}
+
+// findDuplicates groups responses that normalize to the same text within a
+// question across all logs. Groups follow the configured question order, then
+// the date of their first occurrence.
+func findDuplicates(logs []DayLog, base []string) []duplicateGroup {
+	type key struct{ question, response string }
+	byKey := make(map[key]*duplicateGroup)
+	var order []key
+	answers := make(map[string][]Answer)
+	for _, log := range logs {
+		for q, list := range log.Answers {
+			answers[q] = append(answers[q], list...)
+			for _, ans := range list {
+				normalized := normalizeResponse(ans.Response)
+				if normalized == "" {
+					continue
+				}
+				k := key{q, normalized}
+				group, ok := byKey[k]
+				if !ok {
+					group = &duplicateGroup{Question: q, Response: strings.TrimSpace(ans.Response)}
+					byKey[k] = group
+					order = append(order, k)
+				}
+				group.Occurrences = append(group.Occurrences, datedAnswer{Date: log.Date, Answer: ans})
+			}
+		}
+	}
+
+	rank := make(map[string]int)
+	for i, q := range OrderQuestions(answers, base) {
+		rank[q] = i
+	}
+	var groups []duplicateGroup
+	for _, k := range order {
+		group := byKey[k]
+		if len(group.Occurrences) < 2 {
+			continue
+		}
+		sort.SliceStable(group.Occurrences, func(i, j int) bool {
+			a, b := group.Occurrences[i], group.Occurrences[j]
+			if a.Date != b.Date {
+				return a.Date < b.Date
+			}
+			return answerTime(a.Answer).Before(answerTime(b.Answer))
+		})
+		groups = append(groups, *group)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if rank[groups[i].Question] != rank[groups[j].Question] {
+			return rank[groups[i].Question] < rank[groups[j].Question]
+		}
+		return groups[i].Occurrences[0].Date < groups[j].Occurrences[0].Date
+	})
+	return groups
+}
+
+// normalizeResponse lowercases a response, collapses whitespace and drops
+// trailing punctuation so "Fixed CI." and "fixed  ci" compare equal.
+func normalizeResponse(response string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(response)), " ")
+	return strings.TrimRight(normalized, ".!?;,")
+}
+
+func renderDuplicates(groups []duplicateGroup, settings Settings) string {
+	var b strings.Builder
+	current := ""
+	for i, group := range groups {
+		if i == 0 || group.Question != current {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(group.Question + "\n")
+			current = group.Question
+		}
+		b.WriteString(fmt.Sprintf("  - %s (%d times)\n", group.Response, len(group.Occurrences)))
+		for _, occ := range group.Occurrences {
+			b.WriteString(fmt.Sprintf("      %s [%s]\n", occ.Date, settings.DisplayTime(occ.Answer.Time)))
+		}
+	}
+	return b.String()
+}
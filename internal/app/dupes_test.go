@@ -0,0 +1,61 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDuplicatesAcrossDays(t *testing.T) {
+	logs := []DayLog{
+		{Date: "2026-03-03", Answers: map[string][]Answer{
+			"Done?":    {{Time: "2026-03-03T09:00:00Z", Response: "fixed  CI."}, {Time: "2026-03-03T10:00:00Z", Response: "standup"}},
+			"Blocked?": {{Time: "2026-03-03T11:00:00Z", Response: "review"}},
+		}},
+		{Date: "2026-03-02", Answers: map[string][]Answer{
+			"Done?":    {{Time: "2026-03-02T09:00:00Z", Response: "Fixed CI"}, {Time: "2026-03-02T15:00:00Z", Response: "standup"}},
+			"Blocked?": {{Time: "2026-03-02T11:00:00Z", Response: "standup"}, {Time: "2026-03-02T12:00:00Z", Response: "Review!"}},
+		}},
+		{Date: "2026-03-04", Answers: map[string][]Answer{
+			"Done?": {{Time: "2026-03-04T09:00:00Z", Response: "fixed ci"}, {Time: "2026-03-04T10:00:00Z", Response: "  "}, {Time: "2026-03-04T11:00:00Z", Response: ""}},
+		}},
+	}
+	groups := findDuplicates(logs, []string{"Done?", "Blocked?"})
+	type summary struct {
+		question string
+		dates    []string
+	}
+	var got []summary
+	for _, g := range groups {
+		var dates []string
+		for _, occ := range g.Occurrences {
+			dates = append(dates, occ.Date)
+		}
+		got = append(got, summary{g.Question, dates})
+	}
+	want := []summary{
+		{"Done?", []string{"2026-03-02", "2026-03-03", "2026-03-04"}},
+		{"Done?", []string{"2026-03-02", "2026-03-03"}},
+		{"Blocked?", []string{"2026-03-02", "2026-03-03"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("groups = %+v, want %+v", got, want)
+	}
+	if groups[1].Response != "standup" {
+		t.Fatalf("second group = %q, want standup; the same text under another question must not merge", groups[1].Response)
+	}
+}
+
+func TestRenderDuplicates(t *testing.T) {
+	groups := []duplicateGroup{{
+		Question: "Done?",
+		Response: "standup",
+		Occurrences: []datedAnswer{
+			{Date: "2026-03-02", Answer: Answer{Time: "2026-03-02T09:00:00Z"}},
+			{Date: "2026-03-03", Answer: Answer{Time: "2026-03-03T09:30:00Z"}},
+		},
+	}}
+	want := "Done?\n  - standup (2 times)\n      2026-03-02 [09:00]\n      2026-03-03 [09:30]\n"
+	if got := renderDuplicates(groups, Config{DisplayTimezone: "UTC"}.Settings()); got != want {
+		t.Fatalf("renderDuplicates:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Exit codes reported by the wlog binaries, so scripts can tell an empty
+// result or a broken config apart from other failures.
+const (
+	ExitOK        = 0
+	ExitError     = 1
+	ExitNoEntries = 2
+	ExitConfig    = 3
+)
+
+var (
+	// ErrNoEntries is returned by view and cat after they have printed their
+	// no-entries message, so it is not reported again on stderr.
+	ErrNoEntries = errors.New("no entries found")
+	// ErrConfig matches errors caused by an unreadable or invalid config.
+	ErrConfig = errors.New("config error")
+)
+
+// configError keeps the wrapped error's message while matching ErrConfig.
+type configError struct {
+	err error
+}
+
+func (e configError) Error() string        { return e.err.Error() }
+func (e configError) Unwrap() error        { return e.err }
+func (e configError) Is(target error) bool { return target == ErrConfig }
+
+func newConfigError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return configError{err: err}
+}
+
+// ExitCode maps an error returned by Run to the process exit status.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, ErrNoEntries):
+		return ExitNoEntries
+	case errors.Is(err, ErrConfig):
+		return ExitConfig
+	default:
+		return ExitError
+	}
+}
+
+// ReportError prints err to stderr, unless the command already explained it
+// on stdout, and returns the exit code to use.
+func ReportError(err error) int {
+	if err != nil && !errors.Is(err, ErrNoEntries) {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return ExitCode(err)
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"generic", errors.New("boom"), ExitError},
+		{"no entries", ErrNoEntries, ExitNoEntries},
+		{"wrapped no entries", fmt.Errorf("view: %w", ErrNoEntries), ExitNoEntries},
+		{"config", newConfigError(errors.New("bad")), ExitConfig},
+		{"wrapped config", fmt.Errorf("load: %w", newConfigError(errors.New("bad"))), ExitConfig},
+		{"unsupported schema", newConfigError(fmt.Errorf("config.json: %w", ErrUnsupportedSchema)), ExitConfig},
+	}
+	for _, tt := range tests {
+		if got := ExitCode(tt.err); got != tt.want {
+			t.Errorf("%s: ExitCode = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRunConfigErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, path string)
+		args  []string
+		want  int
+	}{
+		{
+			name:  "missing file falls back to defaults",
+			setup: func(t *testing.T, path string) {},
+			args:  []string{"questions"},
+			want:  ExitOK,
+		},
+		{
+			name:  "invalid JSON",
+			setup: func(t *testing.T, path string) { writeFile(t, path, "{not json") },
+			args:  []string{"questions"},
+			want:  ExitConfig,
+		},
+		{
+			name:  "wrong field type",
+			setup: func(t *testing.T, path string) { writeFile(t, path, `{"questions":"one"}`) },
+			args:  []string{"questions"},
+			want:  ExitConfig,
+		},
+		{
+			name:  "unsupported schema",
+			setup: func(t *testing.T, path string) { writeFile(t, path, `{"schemaVersion":999}`) },
+			args:  []string{"questions"},
+			want:  ExitConfig,
+		},
+		{
+			name: "unreadable file",
+			setup: func(t *testing.T, path string) {
+				if err := os.MkdirAll(path, 0o755); err != nil {
+					t.Fatal(err)
+				}
+			},
+			args: []string{"questions"},
+			want: ExitConfig,
+		},
+		{
+			name:  "invalid config still allows ls",
+			setup: func(t *testing.T, path string) { writeFile(t, path, "{not json") },
+			args:  []string{"ls"},
+			want:  ExitOK,
+		},
+		{
+			name:  "invalid config still allows help",
+			setup: func(t *testing.T, path string) { writeFile(t, path, "{not json") },
+			args:  []string{"help"},
+			want:  ExitOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			path := filepath.Join(t.TempDir(), "config.json")
+			t.Setenv("WLOG_CONFIG_FILE", path)
+			tt.setup(t, path)
+			var err error
+			captureStdout(t, func() {
+				err = Run(tt.args, BuildInfo{})
+			})
+			if got := ExitCode(err); got != tt.want {
+				t.Fatalf("exit code = %d, want %d (err: %v)", got, tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type datedAnswer struct {
+	Date   string
+	Answer Answer
+}
+
+func RunExport(args []string, questions []string, settings Settings) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing export format, expected: by-question, form")
+	}
+	switch args[0] {
+	case "by-question":
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		logs, err := collectDayLogs(opts)
+		if err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			fmt.Printf("No entries found for %s.\n", opts.label())
+			return nil
+		}
+		fmt.Print(renderByQuestion(logs, questions, settings))
+		return nil
+	case "form":
+		return runExportForm(args[1:], questions)
+	default:
+		return fmt.Errorf("unknown export format %q, expected: by-question, form", args[0])
+	}
+}
+
+// collectDayLogs reads every existing day file in the interval described by
+// opts, applying its time-of-day filter.
+func collectDayLogs(opts ViewOptions) ([]DayLog, error) {
+	start, end, err := opts.bounds()
+	if err != nil {
+		return nil, err
+	}
+	var logs []DayLog
+	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
+		entry, err := opts.readDay(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			continue
+		}
+		filtered := opts.filterDayLog(*entry)
+		if !dayLogHasEntries(filtered) {
+			continue
+		}
+		logs = append(logs, filtered)
+	}
+	return logs, nil
+}
+
+func groupByQuestion(logs []DayLog) map[string][]datedAnswer {
+	grouped := make(map[string][]datedAnswer)
+	for _, log := range logs {
+		for q, answers := range log.Answers {
+			for _, ans := range answers {
+				grouped[q] = append(grouped[q], datedAnswer{Date: log.Date, Answer: ans})
+			}
+		}
+	}
+	for q := range grouped {
+		sort.SliceStable(grouped[q], func(i, j int) bool {
+			return grouped[q][i].Date < grouped[q][j].Date
+		})
+	}
+	return grouped
+}
+
+func renderByQuestion(logs []DayLog, base []string, settings Settings) string {
+	grouped := groupByQuestion(logs)
+	var b strings.Builder
+	for _, q := range mergeQuestionsForList(base, DayLog{Answers: flattenGrouped(grouped)}) {
+		b.WriteString(q + "\n")
+		answers := grouped[q]
+		if len(answers) == 0 {
+			b.WriteString("  No entries.\n\n")
+			continue
+		}
+		for _, da := range answers {
+			b.WriteString(fmt.Sprintf("  - %s [%s] %s\n", da.Date, settings.DisplayTime(da.Answer.Time), da.Answer.Response))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func flattenGrouped(grouped map[string][]datedAnswer) map[string][]Answer {
+	flat := make(map[string][]Answer, len(grouped))
+	for q, answers := range grouped {
+		for _, da := range answers {
+			flat[q] = append(flat[q], da.Answer)
+		}
+	}
+	return flat
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExportByQuestion(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Done?", "Blocked on?", "Unused?"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+	writeDay(t, yesterday, DayLog{Answers: map[string][]Answer{
+		"Done?":       {{Time: yesterday.Add(9 * time.Hour).Format(time.RFC3339), Response: "standup"}},
+		"Blocked on?": {{Time: yesterday.Add(10 * time.Hour).Format(time.RFC3339), Response: "review"}},
+	}})
+	writeDay(t, today, DayLog{Answers: map[string][]Answer{
+		"Blocked on?": {{Time: today.Add(11 * time.Hour).Format(time.RFC3339), Response: "CI"}},
+		"Extra":       {{Time: today.Add(12 * time.Hour).Format(time.RFC3339), Response: "ad hoc"}},
+	}})
+
+	out, err := runOutput(t, "export", "by-question", "--days", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, d := yesterday.Format("2006-01-02"), today.Format("2006-01-02")
+	want := "Done?\n" +
+		"  - " + y + " [09:00] standup\n\n" +
+		"Blocked on?\n" +
+		"  - " + y + " [10:00] review\n" +
+		"  - " + d + " [11:00] CI\n\n" +
+		"Unused?\n" +
+		"  No entries.\n\n" +
+		"Extra\n" +
+		"  - " + d + " [12:00] ad hoc\n\n"
+	if out != want {
+		t.Fatalf("export by-question:\n%s\nwant:\n%s", out, want)
+	}
+
+	out, err = runOutput(t, "export", "by-question", "tomorrow")
+	if err != nil || out != "No entries found for tomorrow.\n" {
+		t.Fatalf("empty export = %q, %v", out, err)
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type questionAnswer struct {
+	Question string
+	Answer   Answer
+}
+
+// flattenDay returns every answer of the day paired with its question,
+// sorted by time. Answers logged at the same moment keep question order.
+func flattenDay(log DayLog, questions []string) []questionAnswer {
+	var flat []questionAnswer
+	for _, q := range OrderQuestions(log.Answers, questions) {
+		for _, ans := range log.Answers[q] {
+			flat = append(flat, questionAnswer{Question: q, Answer: ans})
+		}
+	}
+	sort.SliceStable(flat, func(i, j int) bool {
+		return answerTime(flat[i].Answer).Before(answerTime(flat[j].Answer))
+	})
+	return flat
+}
+
+func renderFlatDay(log DayLog, questions []string, settings Settings) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s\n", log.Date))
+	date, _ := time.ParseInLocation("2006-01-02", log.Date, time.Local)
+	for _, qa := range flattenDay(log, questions) {
+		b.WriteString(fmt.Sprintf("  %s [%s] %s\n", settings.DisplayTime(qa.Answer.Time), RenderQuestion(qa.Question, date), qa.Answer.Response))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderOneline prints every answer across logs as one grep-friendly line,
+// "2006-01-02 key 15:04 response", sorted by time.
+func renderOneline(logs []DayLog, questions []string, settings Settings) string {
+	var all []datedQuestionAnswer
+	for _, log := range logs {
+		for _, qa := range flattenDay(log, questions) {
+			all = append(all, datedQuestionAnswer{Date: log.Date, questionAnswer: qa})
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return answerTime(all[i].Answer).Before(answerTime(all[j].Answer))
+	})
+	var b strings.Builder
+	for _, qa := range all {
+		response := strings.Join(strings.Fields(qa.Answer.Response), " ")
+		b.WriteString(fmt.Sprintf("%s %s %s %s\n", qa.Date, questionKey(qa.Question), settings.DisplayTime(qa.Answer.Time), response))
+	}
+	return b.String()
+}
+
+type datedQuestionAnswer struct {
+	Date string
+	questionAnswer
+}
+
+// questionKey turns a question into one grep-friendly token for --oneline:
+// its lowercase words joined with "-", so "What did you do yesterday?"
+// becomes "what-did-you-do-yesterday". The key is built from the whole text
+// so different questions keep different keys. Questions without letters or
+// digits become "q".
+func questionKey(question string) string {
+	words := questionWords(question)
+	if len(words) == 0 {
+		return "q"
+	}
+	return strings.Join(words, "-")
+}
+
+// questionWords splits a question into its lowercase runs of letters and
+// digits.
+func questionWords(question string) []string {
+	return strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
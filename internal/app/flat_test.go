@@ -0,0 +1,66 @@
+package app
+
+import "testing"
+
+func TestQuestionKey(t *testing.T) {
+	tests := []struct {
+		question string
+		want     string
+	}{
+		{"What did you do yesterday?", "what-did-you-do-yesterday"},
+		{"What will/did you do today?", "what-will-did-you-do-today"},
+		{"Are you blocked with anything?", "are-you-blocked-with-anything"},
+		{"  Mood (1-5)  ", "mood-1-5"},
+		{"Qué tal?", "qué-tal"},
+		{"???", "q"},
+		{"", "q"},
+	}
+	for _, tt := range tests {
+		if got := questionKey(tt.question); got != tt.want {
+			t.Errorf("questionKey(%q) = %q, want %q", tt.question, got, tt.want)
+		}
+	}
+	if questionKey("What did you do today?") == questionKey("What will you do today?") {
+		t.Error("questions sharing their last word got the same key")
+	}
+}
+
+func TestRenderOneline(t *testing.T) {
+	questions := []string{"What did you do today?", "What will you do today?"}
+	logs := []DayLog{
+		{Date: "2026-03-01", Answers: map[string][]Answer{
+			"What will you do today?": {{Time: "2026-03-01T17:00:00Z", Response: "plan  the\nrelease"}},
+			"What did you do today?":  {{Time: "2026-03-01T09:00:00Z", Response: "standup"}},
+		}},
+		{Date: "2026-03-02", Answers: map[string][]Answer{
+			"What will you do today?": {{Time: "2026-03-02T09:00:00Z", Response: "ship"}},
+			"What did you do today?":  {{Time: "2026-03-02T09:00:00Z", Response: "review"}},
+			"Extra":                   {{Time: "2026-03-02T08:00:00Z", Response: "early"}},
+		}},
+	}
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	want := "2026-03-01 what-did-you-do-today 09:00 standup\n" +
+		"2026-03-01 what-will-you-do-today 17:00 plan the release\n" +
+		"2026-03-02 extra 08:00 early\n" +
+		"2026-03-02 what-did-you-do-today 09:00 review\n" +
+		"2026-03-02 what-will-you-do-today 09:00 ship\n"
+	if got := renderOneline(logs, questions, settings); got != want {
+		t.Fatalf("renderOneline =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderFlatDaySortsAcrossQuestions(t *testing.T) {
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	log := DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+		"Done?": {{Time: "2026-03-02T09:00:00Z", Response: "standup"}, {Time: "2026-03-02T15:00:00Z", Response: "review"}},
+		"Next?": {{Time: "2026-03-02T11:30:00Z", Response: "release"}, {Time: "2026-03-02T09:00:00Z", Response: "same time"}},
+	}}
+	want := "2026-03-02\n" +
+		"  09:00 [Done?] standup\n" +
+		"  09:00 [Next?] same time\n" +
+		"  11:30 [Next?] release\n" +
+		"  15:00 [Done?] review\n\n"
+	if got := renderFlatDay(log, []string{"Done?", "Next?"}, settings); got != want {
+		t.Fatalf("flat day:\n%s\nwant:\n%s", got, want)
+	}
+}
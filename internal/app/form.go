@@ -0,0 +1,222 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// The fill-in form written by `wlog export form` and read back by
+// `wlog import form`:
+//
+//	# wlog form 2006-01-02
+//	## <question>
+//	- <answer>
+//	- [15:04] <answer logged at a given time>
+//
+// The "# wlog form" header must come first and names the day. Each "## "
+// line starts a question, taken verbatim, and each "- " line beneath it is
+// one answer; empty bullets are skipped. Other "#" lines and blank lines are
+// comments, and anything else is an error.
+const (
+	formHeader     = "# wlog form "
+	formQuestion   = "## "
+	formBullet     = "-"
+	formBlankSlots = 3
+)
+
+type formEntry struct {
+	Question string
+	Response string
+	Clock    *int
+}
+
+func renderForm(day time.Time, questions []string) string {
+	var b strings.Builder
+	b.WriteString(formHeader + day.Format("2006-01-02") + "\n")
+	b.WriteString("# One answer per \"- \" line, optionally prefixed with [HH:MM]. Add bullets as\n")
+	b.WriteString("# needed; empty ones are skipped. Read back with: wlog import form <file>\n")
+	for _, q := range questions {
+		b.WriteString("\n" + formQuestion + q + "\n")
+		for i := 0; i < formBlankSlots; i++ {
+			b.WriteString(formBullet + " \n")
+		}
+	}
+	return b.String()
+}
+
+func parseForm(r io.Reader) (time.Time, []formEntry, error) {
+	var day time.Time
+	var entries []formEntry
+	question := ""
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if day.IsZero() {
+			if line == "" {
+				continue
+			}
+			value, ok := strings.CutPrefix(line, formHeader)
+			if !ok {
+				return day, nil, fmt.Errorf("line %d: expected %q header", n, strings.TrimSpace(formHeader)+" YYYY-MM-DD")
+			}
+			parsed, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(value), time.Local)
+			if err != nil {
+				return day, nil, fmt.Errorf("line %d: invalid form date %q", n, value)
+			}
+			day = parsed
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, formQuestion):
+			question = strings.TrimSpace(strings.TrimPrefix(line, formQuestion))
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, formBullet):
+			if question == "" {
+				return day, nil, fmt.Errorf("line %d: answer before any %q question", n, strings.TrimSpace(formQuestion))
+			}
+			entry, err := parseFormAnswer(strings.TrimSpace(strings.TrimPrefix(line, formBullet)))
+			if err != nil {
+				return day, nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			if entry.Response == "" {
+				continue
+			}
+			entry.Question = question
+			entries = append(entries, entry)
+		default:
+			return day, nil, fmt.Errorf("line %d: expected a %q question or %q answer, got %q", n, strings.TrimSpace(formQuestion), formBullet+" ", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return day, nil, err
+	}
+	if day.IsZero() {
+		return day, nil, fmt.Errorf("empty form, expected %q header", strings.TrimSpace(formHeader)+" YYYY-MM-DD")
+	}
+	return day, entries, nil
+}
+
+// parseFormAnswer splits an optional leading [HH:MM] off a bullet's text.
+// Any other bracketed prefix, like "[WIP]", is part of the answer.
+func parseFormAnswer(text string) (formEntry, error) {
+	entry := formEntry{Response: text}
+	rest, ok := strings.CutPrefix(text, "[")
+	if !ok {
+		return entry, nil
+	}
+	clock, response, found := strings.Cut(rest, "]")
+	if !found || !isClockShape(clock) {
+		return entry, nil
+	}
+	minutes, err := parseClock(clock)
+	if err != nil {
+		return entry, fmt.Errorf("invalid time %q, expected [HH:MM]", clock)
+	}
+	entry.Clock = &minutes
+	entry.Response = strings.TrimSpace(response)
+	return entry, nil
+}
+
+// isClockShape reports whether value looks like H:MM or HH:MM, so an
+// out-of-range time is still reported rather than kept as text.
+func isClockShape(value string) bool {
+	hours, minutes, ok := strings.Cut(value, ":")
+	return ok && len(hours) >= 1 && len(hours) <= 2 && len(minutes) == 2 && isDigits(hours) && isDigits(minutes)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+func runExportForm(args []string, questions []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("usage: wlog export form [date]")
+	}
+	day := DayFloor(time.Now())
+	if len(args) == 1 {
+		var err error
+		if day, err = parseAddDate(args[0]); err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD or a single day like \"yesterday\"", args[0])
+		}
+	}
+	if len(questions) == 0 {
+		return fmt.Errorf("no questions configured")
+	}
+	fmt.Print(renderForm(day, questions))
+	return nil
+}
+
+// RunImport reads a filled-in form from a file, or stdin when the path is
+// omitted or "-", and appends its answers to the form's day.
+func RunImport(args []string, cfg Config, dryRun bool) error {
+	if len(args) == 0 || args[0] != "form" {
+		return fmt.Errorf("missing import format, expected: form")
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("usage: wlog import form [file]")
+	}
+	in := io.Reader(os.Stdin)
+	if len(args) == 2 && args[1] != "-" {
+		f, err := os.Open(args[1])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	day, entries, err := parseForm(in)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Printf("No answers filled in for %s.\n", day.Format("2006-01-02"))
+		return nil
+	}
+	if err := cfg.CheckEntryDay(day); err != nil {
+		return err
+	}
+
+	log, err := LoadDayLog(day)
+	if err != nil {
+		return err
+	}
+	settings := cfg.Settings()
+	now := time.Now()
+	var added []plannedAnswer
+	for _, entry := range entries {
+		if cfg.DedupeEntriesEnabled() && HasResponse(log.Answers[entry.Question], entry.Response) {
+			fmt.Printf("Duplicate skipped: %s\n", entry.Response)
+			continue
+		}
+		_, timestamp := addOptions{Date: &day, Clock: entry.Clock}.entryTime(now, settings)
+		ans := Answer{Time: timestamp, Response: entry.Response}
+		log.Answers[entry.Question] = insertByTime(log.Answers[entry.Question], ans)
+		added = append(added, plannedAnswer{Question: entry.Question, Answer: ans})
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	if dryRun {
+		path, err := DayFilePath(day)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, added, settings)
+		return nil
+	}
+	if err := SaveDayLog(day, log, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Imported %d %s into %s.\n", len(added), pluralize(len(added), "entry", "entries"), day.Format("2006-01-02"))
+	return nil
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFormAnswer(t *testing.T) {
+	tests := []struct {
+		text     string
+		response string
+		clock    int
+		ok       bool
+	}{
+		{"plain answer", "plain answer", -1, true},
+		{"[09:30] standup", "standup", 9*60 + 30, true},
+		{"[9:05]   early", "early", 9*60 + 5, true},
+		{"[WIP] fix login", "[WIP] fix login", -1, true},
+		{"[x] done", "[x] done", -1, true},
+		{"[1:2] odd", "[1:2] odd", -1, true},
+		{"[unclosed", "[unclosed", -1, true},
+		{"[] empty", "[] empty", -1, true},
+		{"[25:00] late", "", -1, false},
+		{"[12:60] late", "", -1, false},
+	}
+	for _, tt := range tests {
+		entry, err := parseFormAnswer(tt.text)
+		if (err == nil) != tt.ok {
+			t.Errorf("parseFormAnswer(%q) error = %v, want ok %v", tt.text, err, tt.ok)
+			continue
+		}
+		if !tt.ok {
+			continue
+		}
+		if entry.Response != tt.response {
+			t.Errorf("parseFormAnswer(%q) response = %q, want %q", tt.text, entry.Response, tt.response)
+		}
+		if got := clockValue(entry.Clock); got != tt.clock {
+			t.Errorf("parseFormAnswer(%q) clock = %d, want %d", tt.text, got, tt.clock)
+		}
+	}
+}
+
+func TestExportFillImportForm(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	questions := []string{"What did you do?", "Blockers?"}
+	form := captureStdout(t, func() {
+		if err := RunExport([]string{"form", "2026-03-02"}, questions, Settings{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	fills := map[string][]string{
+		"What did you do?": {"[09:30] standup", "[11:00] [WIP] fix login"},
+		"Blockers?":        {"[x] waiting on review"},
+	}
+	var filled []string
+	question := ""
+	for _, line := range strings.Split(form, "\n") {
+		if q, ok := strings.CutPrefix(line, formQuestion); ok {
+			question = q
+		}
+		if line == formBullet+" " && len(fills[question]) > 0 {
+			line += fills[question][0]
+			fills[question] = fills[question][1:]
+		}
+		filled = append(filled, line)
+	}
+	path := filepath.Join(t.TempDir(), "form.md")
+	if err := os.WriteFile(path, []byte(strings.Join(filled, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunImport([]string{"form", path}, Config{Questions: questions}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Imported 3 entries into 2026-03-02.") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	log, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	did := log.Answers["What did you do?"]
+	if len(did) != 2 || did[0].Response != "standup" || did[1].Response != "[WIP] fix login" {
+		t.Fatalf("answers = %+v", did)
+	}
+	at, err := time.Parse(time.RFC3339, did[0].Time)
+	if err != nil || at.In(time.Local).Format("15:04") != "09:30" {
+		t.Fatalf("clocked answer time = %q, %v", did[0].Time, err)
+	}
+	if blockers := log.Answers["Blockers?"]; len(blockers) != 1 || blockers[0].Response != "[x] waiting on review" {
+		t.Fatalf("blockers = %+v", blockers)
+	}
+}
+
+func clockValue(clock *int) int {
+	if clock == nil {
+		return -1
+	}
+	return *clock
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+type grepOptions struct {
+	Strict          bool
+	IncludeArchived bool
+	Context         bool
+}
+
+// grepMatch is one hit. With --context, a matched day file question carries
+// every answer for that question and day in Lines, not just the hits.
+type grepMatch struct {
+	Path  string
+	Text  string
+	Lines []grepLine
+}
+
+type grepLine struct {
+	Text    string
+	Matched bool
+}
+
+func RunGrep(args []string) error {
+	var opts grepOptions
+	var words []string
+	for _, arg := range args {
+		switch arg {
+		case "--strict":
+			opts.Strict = true
+		case "--include-archived":
+			opts.IncludeArchived = true
+		case "--context":
+			opts.Context = true
+		default:
+			words = append(words, arg)
+		}
+	}
+	term := strings.TrimSpace(strings.Join(words, " "))
+	if term == "" {
+		return fmt.Errorf("missing search term")
+	}
+	matches, err := grepStorage(term, opts)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		fmt.Printf("No matches found for %q.\n", term)
+		if !opts.IncludeArchived {
+			printArchivedHint()
+		}
+		return nil
+	}
+	fmt.Print(renderGrepMatches(matches))
+	return nil
+}
+
+// printArchivedHint points at --include-archived when there are archived
+// days that a search skipped.
+func printArchivedHint() {
+	dir, err := ArchiveDir()
+	if err != nil {
+		return
+	}
+	paths, err := dayFilesIn(dir)
+	if err != nil || len(paths) == 0 {
+		return
+	}
+	fmt.Printf("%d archived day %s not searched, pass --include-archived to search them.\n", len(paths), pluralize(len(paths), "file was", "files were"))
+}
+
+// renderGrepMatches prints one "path: text" line per match. Context matches
+// follow their day and question header with the answers indented beneath,
+// the matched ones marked with "*".
+func renderGrepMatches(matches []grepMatch) string {
+	var b strings.Builder
+	for _, match := range matches {
+		b.WriteString(fmt.Sprintf("%s: %s\n", match.Path, match.Text))
+		for _, line := range match.Lines {
+			marker := " "
+			if line.Matched {
+				marker = "*"
+			}
+			b.WriteString(fmt.Sprintf("  %s %s\n", marker, line.Text))
+		}
+	}
+	return b.String()
+}
+
+// grepStorage searches the config questions and every day file for a
+// case-insensitive term. Day file questions are only reported on their own
+// when none of their responses matched. Unreadable day files are skipped with
+// a warning unless strict is set; archived days are searched with
+// includeArchived.
+func grepStorage(term string, opts grepOptions) ([]grepMatch, error) {
+	needle := strings.ToLower(term)
+	var matches []grepMatch
+
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range cfg.Questions {
+		if strings.Contains(strings.ToLower(q), needle) {
+			matches = append(matches, grepMatch{Path: cfgPath, Text: q})
+		}
+	}
+
+	paths, err := listDayFiles(opts.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		log, err := readDayLogFile(path)
+		if err != nil {
+			if opts.Strict || !isDecodeError(err) {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			Warnf("skipping %s: %v\n", path, err)
+			continue
+		}
+		for _, q := range OrderQuestions(log.Answers, cfg.Questions) {
+			found := false
+			var lines []grepLine
+			for _, ans := range log.Answers[q] {
+				matched := strings.Contains(strings.ToLower(ans.Response), needle)
+				lines = append(lines, grepLine{Text: ans.Response, Matched: matched})
+				if matched {
+					found = true
+					if !opts.Context {
+						matches = append(matches, grepMatch{Path: path, Text: fmt.Sprintf("[%s] %s", q, ans.Response)})
+					}
+				}
+			}
+			if found && opts.Context {
+				matches = append(matches, grepMatch{Path: path, Text: fmt.Sprintf("[%s]", q), Lines: lines})
+			}
+			if !found && strings.Contains(strings.ToLower(q), needle) {
+				matches = append(matches, grepMatch{Path: path, Text: q})
+			}
+		}
+	}
+	return matches, nil
+}
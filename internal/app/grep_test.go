@@ -0,0 +1,75 @@
+package app
+
+import "testing"
+
+func TestGrepContextShowsOtherAnswers(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Done?", "Next?"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{
+		"Done?": {
+			{Time: "2026-03-02T09:00:00Z", Response: "standup"},
+			{Time: "2026-03-02T10:00:00Z", Response: "fixed the login bug"},
+			{Time: "2026-03-02T11:00:00Z", Response: "code review"},
+		},
+		"Next?": {{Time: "2026-03-02T12:00:00Z", Response: "release"}},
+	}})
+	path, err := DayFilePath(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunGrep([]string{"--context", "login"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	want := path + ": [Done?]\n" +
+		"    standup\n" +
+		"  * fixed the login bug\n" +
+		"    code review\n"
+	if out != want {
+		t.Fatalf("grep --context output:\n%s\nwant:\n%s", out, want)
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunGrep([]string{"login"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if want := path + ": [Done?] fixed the login bug\n"; out != want {
+		t.Fatalf("grep output = %q, want %q", out, want)
+	}
+}
+
+func TestGrepFindsConfigOnlyMatch(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Progress on Apollo?", "Blockers?"}}); err != nil {
+		t.Fatal(err)
+	}
+	writeDay(t, mustDay(t, "2026-03-02"), dayWith(mustDay(t, "2026-03-02"), "Blockers?", "none"))
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunGrep([]string{"apollo"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if want := cfgPath + ": Progress on Apollo?\n"; out != want {
+		t.Fatalf("grep output = %q, want %q", out, want)
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunGrep([]string{"hermes"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out != "No matches found for \"hermes\".\n" {
+		t.Fatalf("grep output = %q", out)
+	}
+}
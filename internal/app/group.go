@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	GroupByDay   = "day"
+	GroupByWeek  = "week"
+	GroupByMonth = "month"
+)
+
+func parseGroupBy(value string) (string, error) {
+	switch value {
+	case GroupByDay, GroupByWeek, GroupByMonth:
+		return value, nil
+	}
+	return "", fmt.Errorf("invalid --group-by value %q, expected day, week or month", value)
+}
+
+// periodStart returns the first day of the period containing day: the Monday
+// of its week, the first of its month, or the day itself.
+func periodStart(day time.Time, groupBy string) time.Time {
+	switch groupBy {
+	case GroupByWeek:
+		return StartOfWeek(day)
+	case GroupByMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	default:
+		return DayFloor(day)
+	}
+}
+
+func periodHeader(start time.Time, groupBy string, settings Settings) string {
+	switch groupBy {
+	case GroupByWeek:
+		return fmt.Sprintf("=== Week of %s ===\n\n", settings.FormatDayLabel(start))
+	case GroupByMonth:
+		return fmt.Sprintf("=== %s ===\n\n", start.Format("January 2006"))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"testing"
+)
+
+func TestPeriodStartAcrossMonthBoundary(t *testing.T) {
+	tests := []struct {
+		day, groupBy, want string
+	}{
+		{"2026-03-29", GroupByWeek, "2026-03-23"},
+		{"2026-03-30", GroupByWeek, "2026-03-30"},
+		{"2026-04-02", GroupByWeek, "2026-03-30"},
+		{"2026-04-05", GroupByWeek, "2026-03-30"},
+		{"2026-04-06", GroupByWeek, "2026-04-06"},
+		{"2026-03-31", GroupByMonth, "2026-03-01"},
+		{"2026-04-02", GroupByMonth, "2026-04-01"},
+		{"2026-04-02", GroupByDay, "2026-04-02"},
+	}
+	for _, tt := range tests {
+		if got := periodStart(mustDay(t, tt.day), tt.groupBy).Format("2006-01-02"); got != tt.want {
+			t.Errorf("periodStart(%s, %s) = %s, want %s", tt.day, tt.groupBy, got, tt.want)
+		}
+	}
+}
+
+func TestViewGroupByWeek(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, date := range []string{"2026-03-29", "2026-03-31", "2026-04-02", "2026-04-06"} {
+		day := mustDay(t, date)
+		writeDay(t, day, dayWith(day, "Q", "x"))
+	}
+
+	out, err := runOutput(t, "view", "--group-by", "week", "--count-only", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "=== Week of Mon 2026-03-23 ===\n\n" +
+		"2026-03-29: 1 entry (q:1)\n" +
+		"=== Week of Mon 2026-03-30 ===\n\n" +
+		"2026-03-31: 1 entry (q:1)\n" +
+		"2026-04-02: 1 entry (q:1)\n" +
+		"=== Week of Mon 2026-04-06 ===\n\n" +
+		"2026-04-06: 1 entry (q:1)\n" +
+		"Total: 4 entries across 4 days\n"
+	if out != want {
+		t.Fatalf("view --group-by week:\n%s\nwant:\n%s", out, want)
+	}
+
+	out, err = runOutput(t, "view", "--group-by", "day", "--count-only", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2026-03-29: 1 entry (q:1)\n"; out[:len(want)] != want {
+		t.Fatalf("--group-by day added headers:\n%s", out)
+	}
+	if _, err := runOutput(t, "view", "--group-by", "year"); err == nil {
+		t.Fatal("--group-by year accepted")
+	}
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// useTempDirs points the data directory and config file at fresh temporary
+// paths and returns the data directory.
+func useTempDirs(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	dataDir := filepath.Join(root, "data")
+	t.Setenv("WLOG_DATA_DIR", dataDir)
+	t.Setenv("WLOG_CONFIG_FILE", filepath.Join(root, "config", "config.json"))
+	t.Setenv("WLOG_QUIET", "")
+	return dataDir
+}
+
+// captureStdout runs fn and returns what it printed to os.Stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureOutput(t, &os.Stdout, fn)
+}
+
+// captureStderr runs fn and returns what it printed to os.Stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureOutput(t, &os.Stderr, fn)
+}
+
+func captureOutput(t *testing.T, stream **os.File, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := *stream
+	*stream = w
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+	defer func() {
+		*stream = orig
+	}()
+	fn()
+	w.Close()
+	return string(<-done)
+}
+
+// withStdin runs fn with os.Stdin reading input.
+func withStdin(t *testing.T, input string, fn func()) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	stdin := os.Stdin
+	os.Stdin = f
+	defer func() {
+		os.Stdin = stdin
+	}()
+	fn()
+}
+
+// mustDay parses a YYYY-MM-DD date in local time.
+func mustDay(t *testing.T, value string) time.Time {
+	t.Helper()
+	day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return day
+}
+
+// writeDay saves log for day with the zero Settings.
+func writeDay(t *testing.T, day time.Time, log DayLog) {
+	t.Helper()
+	if err := SaveDayLog(day, log, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readDayFile returns the raw contents of the day file for day.
+func readDayFile(t *testing.T, day time.Time) string {
+	t.Helper()
+	path, err := DayFilePath(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
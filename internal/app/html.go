@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"html/template"
+	"os"
+	"strings"
+	"time"
+)
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>wlog: {{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; }
+h2 { border-bottom: 1px solid #ddd; padding-bottom: .25rem; }
+time { color: #888; font-variant-numeric: tabular-nums; margin-right: .5rem; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Days}}<h2>{{.Date}}</h2>
+{{range .Questions}}<h3>{{.Question}}</h3>
+<ul>
+{{range .Answers}}<li><time>{{.Time}}</time>{{if .Pinned}}&#128204; {{end}}{{.Response}}</li>
+{{end}}</ul>
+{{end}}{{end}}</body>
+</html>
+`))
+
+type htmlDay struct {
+	Date      string
+	Questions []htmlQuestion
+}
+
+type htmlQuestion struct {
+	Question string
+	Answers  []htmlAnswer
+}
+
+type htmlAnswer struct {
+	Time     string
+	Response string
+	Pinned   bool
+}
+
+func renderHTML(title string, logs []DayLog, questions []string, settings Settings) (string, error) {
+	days := make([]htmlDay, 0, len(logs))
+	for _, log := range logs {
+		date, _ := time.ParseInLocation("2006-01-02", log.Date, time.Local)
+		day := htmlDay{Date: settings.FormatDayLabel(date)}
+		for _, q := range OrderQuestions(log.Answers, questions) {
+			answers := log.Answers[q]
+			if len(answers) == 0 {
+				continue
+			}
+			hq := htmlQuestion{Question: RenderQuestion(q, date)}
+			for _, idx := range PinnedOrder(answers) {
+				ans := answers[idx]
+				hq.Answers = append(hq.Answers, htmlAnswer{Time: settings.DisplayTime(ans.Time), Response: ans.Response, Pinned: ans.Pinned})
+			}
+			day.Questions = append(day.Questions, hq)
+		}
+		days = append(days, day)
+	}
+	var b strings.Builder
+	err := htmlReport.Execute(&b, struct {
+		Title string
+		Days  []htmlDay
+	}{Title: title, Days: days})
+	return b.String(), err
+}
+
+// openHTMLReport writes the report for the interval to a new file in the
+// system temp directory and opens it in the default browser. The file is left
+// in place, since the browser may read it after wlog exits; the OS temp
+// cleanup removes it eventually.
+func openHTMLReport(opts ViewOptions, questions []string) error {
+	logs, err := collectDayLogs(opts)
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 {
+		fmt.Println(opts.noEntriesMessage())
+		return ErrNoEntries
+	}
+	report, err := renderHTML(opts.label(), logs, questions, opts.Settings)
+	if err != nil {
+		return err
+	}
+	path, err := writeTempReport(report)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", path)
+	return openPath(path)
+}
+
+func writeTempReport(report string) (string, error) {
+	file, err := os.CreateTemp("", "wlog-*.html")
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.WriteString(report); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestViewHTMLOpen(t *testing.T) {
+	useTempDirs(t)
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	rec := &recordingStarter{}
+	defer func(orig commandStarter) { starter = orig }(starter)
+	starter = rec
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "<b>bold</b>", "pinned")
+	log.Answers["Q"][1].Pinned = true
+	writeDay(t, day, log)
+
+	out, err := runOutput(t, "view", "--html-open", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.args) == 0 {
+		t.Fatal("browser not launched")
+	}
+	path := rec.args[len(rec.args)-1]
+	if filepath.Dir(path) != tmp || !strings.HasSuffix(path, ".html") {
+		t.Fatalf("opened %s, want an .html file in %s", path, tmp)
+	}
+	if !strings.Contains(out, path) {
+		t.Fatalf("output does not name the report:\n%s", out)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("report not left in place: %v", err)
+	}
+	report := string(data)
+	if !strings.Contains(report, "Mon 2026-03-02") || !strings.Contains(report, "&lt;b&gt;bold&lt;/b&gt;") {
+		t.Fatalf("report missing the escaped entry:\n%s", report)
+	}
+	if strings.Index(report, "pinned") > strings.Index(report, "bold") {
+		t.Fatalf("pinned entry not listed first:\n%s", report)
+	}
+
+	rec.args = nil
+	if _, err := runOutput(t, "view", "--html-open", "tomorrow"); !errors.Is(err, ErrNoEntries) {
+		t.Fatalf("empty range: err = %v, want ErrNoEntries", err)
+	}
+	if rec.args != nil {
+		t.Fatal("browser launched for an empty range")
+	}
+	if reports, _ := filepath.Glob(filepath.Join(tmp, "wlog-*.html")); len(reports) != 1 {
+		t.Fatalf("reports in temp dir = %v, want only the first", reports)
+	}
+}
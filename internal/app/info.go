@@ -0,0 +1,89 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+type storageInfo struct {
+	DataDir    string
+	ConfigPath string
+	DayFiles   int
+	Entries    int
+	First      time.Time
+	Last       time.Time
+}
+
+func RunInfo() error {
+	info, err := collectStorageInfo()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Data dir:     %s\n", info.DataDir)
+	fmt.Printf("Config file:  %s\n", info.ConfigPath)
+	fmt.Printf("Day files:    %d\n", info.DayFiles)
+	if info.DayFiles > 0 {
+		fmt.Printf("First logged: %s\n", info.First.Format("2006-01-02"))
+		fmt.Printf("Last logged:  %s\n", info.Last.Format("2006-01-02"))
+	}
+	fmt.Printf("Entries:      %d\n", info.Entries)
+	return nil
+}
+
+func collectStorageInfo() (storageInfo, error) {
+	var info storageInfo
+	dir, err := DataDir()
+	if err != nil {
+		return info, err
+	}
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		return info, err
+	}
+	info.DataDir = dir
+	info.ConfigPath = cfgPath
+
+	paths, err := ListDayFiles()
+	if err != nil {
+		return info, err
+	}
+	for _, path := range paths {
+		day, _ := dayFromFileName(filepath.Base(path))
+		log, err := readDayLogFile(path)
+		if err != nil {
+			return info, fmt.Errorf("%s: %w", path, err)
+		}
+		info.DayFiles++
+		for _, answers := range log.Answers {
+			info.Entries += len(answers)
+		}
+		if info.First.IsZero() || day.Before(info.First) {
+			info.First = day
+		}
+		if day.After(info.Last) {
+			info.Last = day
+		}
+	}
+	return info, nil
+}
+
+// CountAnswersByQuestion totals the answers stored for each question across
+// every day file. Unreadable day files are skipped.
+func CountAnswersByQuestion() (map[string]int, error) {
+	paths, err := ListDayFiles()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, path := range paths {
+		log, err := readDayLogFile(path)
+		if err != nil {
+			continue
+		}
+		for q, answers := range log.Answers {
+			counts[q] += len(answers)
+		}
+	}
+	return counts, nil
+}
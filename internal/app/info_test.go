@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunInfoTotals(t *testing.T) {
+	dataDir := useTempDirs(t)
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := runOutput(t, "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("Data dir:     %s\nConfig file:  %s\nDay files:    0\nEntries:      0\n", dataDir, cfgPath)
+	if out != want {
+		t.Fatalf("empty info:\n%s\nwant:\n%s", out, want)
+	}
+
+	for _, d := range []struct {
+		date      string
+		responses []string
+	}{
+		{"2026-03-05", []string{"a", "b"}},
+		{"2026-01-20", []string{"c"}},
+		{"2026-02-11", []string{"d", "e", "f"}},
+	} {
+		day := mustDay(t, d.date)
+		writeDay(t, day, dayWith(day, "Q", d.responses...))
+	}
+	writeFile(t, filepath.Join(dataDir, "notes.txt"), "not a day file")
+
+	info, err := collectStorageInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.DayFiles != 3 || info.Entries != 6 {
+		t.Fatalf("info = %+v, want 3 day files and 6 entries", info)
+	}
+	if info.First.Format("2006-01-02") != "2026-01-20" || info.Last.Format("2006-01-02") != "2026-03-05" {
+		t.Fatalf("range = %s..%s", info.First.Format("2006-01-02"), info.Last.Format("2006-01-02"))
+	}
+	out, err = runOutput(t, "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = fmt.Sprintf("Data dir:     %s\nConfig file:  %s\nDay files:    3\nFirst logged: 2026-01-20\nLast logged:  2026-03-05\nEntries:      6\n", dataDir, cfgPath)
+	if out != want {
+		t.Fatalf("info:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestCountAnswersByQuestion(t *testing.T) {
+	dataDir := useTempDirs(t)
+	for _, date := range []string{"2026-03-01", "2026-03-02"} {
+		day := mustDay(t, date)
+		log := dayWith(day, "Done?", "a", "b")
+		log.Answers["Blocked?"] = nil
+		writeDay(t, day, log)
+	}
+	day := mustDay(t, "2026-03-03")
+	writeDay(t, day, dayWith(day, "Extra", "x"))
+	writeFile(t, filepath.Join(dataDir, "2026-03-04.json"), "{not json")
+
+	counts, err := CountAnswersByQuestion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["Done?"] != 4 || counts["Extra"] != 1 || counts["Blocked?"] != 0 || len(counts) != 3 {
+		t.Fatalf("counts = %v", counts)
+	}
+}
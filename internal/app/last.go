@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type lastEntry struct {
+	Date     string
+	Question string
+	Answer   Answer
+}
+
+// RunLast prints the n most recent entries. Day files that fail to decode
+// are skipped with a warning unless --strict is given.
+func RunLast(args []string, settings Settings) error {
+	n := 1
+	strict := false
+	var counts []string
+	for _, arg := range args {
+		switch {
+		case arg == "--strict":
+			strict = true
+		case strings.HasPrefix(arg, "--"):
+			return fmt.Errorf("unknown last argument %q", arg)
+		default:
+			counts = append(counts, arg)
+		}
+	}
+	if len(counts) > 1 {
+		return fmt.Errorf("too many arguments, expected `wlog last [--strict] [n]`")
+	}
+	if len(counts) == 1 {
+		value, err := strconv.Atoi(counts[0])
+		if err != nil || value <= 0 {
+			return fmt.Errorf("invalid count %q, expected a positive number", counts[0])
+		}
+		n = value
+	}
+
+	entries, err := lastEntries(n, strict)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Println("No entries found.")
+		return nil
+	}
+	for _, e := range entries {
+		fmt.Printf("%s %s  %s: %s\n", e.Date, settings.DisplayTime(e.Answer.Time), e.Question, e.Answer.Response)
+	}
+	return nil
+}
+
+// lastEntries returns the n most recent answers across all day files, newest
+// first. Day files are read newest-first and scanning stops once a whole day
+// has been collected that brings the total to n, since older files can only
+// hold older answers. Undecodable files are skipped unless strict is set.
+func lastEntries(n int, strict bool) ([]lastEntry, error) {
+	paths, err := ListDayFiles()
+	if err != nil {
+		return nil, err
+	}
+	var entries []lastEntry
+	for i := len(paths) - 1; i >= 0 && len(entries) < n; i-- {
+		log, err := readDayLogFile(paths[i])
+		if err != nil {
+			if strict || !isDecodeError(err) {
+				return nil, fmt.Errorf("read %s: %w", filepath.Base(paths[i]), err)
+			}
+			Warnf("skipping %s: %v\n", paths[i], err)
+			continue
+		}
+		for q, answers := range log.Answers {
+			for _, ans := range answers {
+				entries = append(entries, lastEntry{Date: log.Date, Question: q, Answer: ans})
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return answerTime(entries[i].Answer).After(answerTime(entries[j].Answer))
+	})
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries, nil
+}
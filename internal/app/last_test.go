@@ -0,0 +1,84 @@
+package app
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunLastSkipsCorruptDayFiles(t *testing.T) {
+	dataDir := useTempDirs(t)
+	writeDay(t, mustDay(t, "2026-03-01"), DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-01T09:00:00Z", Response: "older"}}}})
+	writeDay(t, mustDay(t, "2026-03-02"), DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-02T09:00:00Z", Response: "newer"}}}})
+	writeFile(t, filepath.Join(dataDir, "2026-03-03.json"), "{not json")
+
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	out := captureStdout(t, func() {
+		if err := RunLast([]string{"2"}, settings); err != nil {
+			t.Fatal(err)
+		}
+	})
+	want := "2026-03-02 09:00  Q: newer\n2026-03-01 09:00  Q: older\n"
+	if out != want {
+		t.Fatalf("output = %q, want %q", out, want)
+	}
+
+	err := RunLast([]string{"--strict", "2"}, settings)
+	if err == nil || !strings.Contains(err.Error(), "2026-03-03.json") {
+		t.Fatalf("--strict error = %v, want one naming the corrupt file", err)
+	}
+}
+
+func TestRunLastArgs(t *testing.T) {
+	useTempDirs(t)
+	for _, args := range [][]string{{"0"}, {"x"}, {"1", "2"}, {"--all"}} {
+		if err := RunLast(args, Settings{}); err == nil {
+			t.Errorf("RunLast(%q) accepted invalid arguments", args)
+		}
+	}
+	out := captureStdout(t, func() {
+		if err := RunLast([]string{"--strict"}, Settings{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out != "No entries found.\n" {
+		t.Fatalf("output = %q", out)
+	}
+}
+
+func TestRunLastDefaultsToOne(t *testing.T) {
+	useTempDirs(t)
+	writeDay(t, mustDay(t, "2026-03-01"), DayLog{Answers: map[string][]Answer{
+		"A": {{Time: "2026-03-01T09:00:00Z", Response: "first"}, {Time: "2026-03-01T17:00:00Z", Response: "third"}},
+	}})
+	writeDay(t, mustDay(t, "2026-03-02"), DayLog{Answers: map[string][]Answer{
+		"A": {{Time: "2026-03-02T08:00:00Z", Response: "fourth"}},
+		"B": {{Time: "2026-03-02T10:00:00Z", Response: "newest"}},
+	}})
+	writeDay(t, mustDay(t, "2026-02-28"), DayLog{Answers: map[string][]Answer{
+		"B": {{Time: "2026-02-28T12:00:00Z", Response: "oldest"}},
+	}})
+
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	out := captureStdout(t, func() {
+		if err := RunLast(nil, settings); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if want := "2026-03-02 10:00  B: newest\n"; out != want {
+		t.Fatalf("last = %q, want %q", out, want)
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunLast([]string{"4"}, settings); err != nil {
+			t.Fatal(err)
+		}
+	})
+	want := "2026-03-02 10:00  B: newest\n" +
+		"2026-03-02 08:00  A: fourth\n" +
+		"2026-03-01 17:00  A: third\n" +
+		"2026-03-01 09:00  A: first\n"
+	if out != want {
+		t.Fatalf("last 4:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// localeStrings holds the words used in day labels. Weekdays are indexed by
+// time.Weekday, so Sunday comes first.
+type localeStrings struct {
+	weekdays  [7]string
+	today     string
+	yesterday string
+	tomorrow  string
+	inDays    string
+	daysAgo   string
+}
+
+// locales is a small built-in table rather than a dependency on x/text, since
+// only weekday abbreviations and relative day names are localized.
+var locales = map[string]localeStrings{
+	"en": {
+		weekdays:  [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		today:     "Today",
+		yesterday: "Yesterday",
+		tomorrow:  "Tomorrow",
+		inDays:    "In %d days",
+		daysAgo:   "%d days ago",
+	},
+	"de": {
+		weekdays:  [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		today:     "Heute",
+		yesterday: "Gestern",
+		tomorrow:  "Morgen",
+		inDays:    "In %d Tagen",
+		daysAgo:   "Vor %d Tagen",
+	},
+	"es": {
+		weekdays:  [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		today:     "Hoy",
+		yesterday: "Ayer",
+		tomorrow:  "Mañana",
+		inDays:    "En %d días",
+		daysAgo:   "Hace %d días",
+	},
+	"fr": {
+		weekdays:  [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		today:     "Aujourd'hui",
+		yesterday: "Hier",
+		tomorrow:  "Demain",
+		inDays:    "Dans %d jours",
+		daysAgo:   "Il y a %d jours",
+	},
+}
+
+func SupportedLocales() []string {
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveLocale looks up a locale by name, accepting region-qualified names
+// such as "de_DE" or "fr-CA" by their language prefix.
+func resolveLocale(name string) (localeStrings, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return locales[defaultLocale], nil
+	}
+	if parts := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == '.' }); len(parts) > 0 {
+		name = parts[0]
+	}
+	loc, ok := locales[name]
+	if !ok {
+		return locales[defaultLocale], fmt.Errorf("unsupported locale %q, expected one of %s", name, strings.Join(SupportedLocales(), ", "))
+	}
+	return loc, nil
+}
+
+func ValidateLocale(name string) error {
+	_, err := resolveLocale(name)
+	return err
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// renderMarkdown renders a day as a level-two date heading with a level-three
+// heading per answered question and one bullet per answer.
+func renderMarkdown(log DayLog, questions []string, settings Settings) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("## %s\n\n", log.Date))
+	date, _ := time.ParseInLocation("2006-01-02", log.Date, time.Local)
+	for _, q := range OrderQuestions(log.Answers, questions) {
+		answers := log.Answers[q]
+		if len(answers) == 0 {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("### %s\n\n", RenderQuestion(q, date)))
+		for _, idx := range PinnedOrder(answers) {
+			ans := answers[idx]
+			b.WriteString(fmt.Sprintf("- %s %s\n", settings.DisplayTime(ans.Time), markdownEscape(ans.Response)))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"[", `\[`,
+	"]", `\]`,
+)
+
+func markdownEscape(text string) string {
+	return markdownReplacer.Replace(text)
+}
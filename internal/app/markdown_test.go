@@ -0,0 +1,45 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	log := DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+		"Done?":   {{Time: "2026-03-02T09:00:00Z", Response: "fixed *the* [bug]"}, {Time: "2026-03-02T10:00:00Z", Response: "pinned", Pinned: true}},
+		"Unused?": nil,
+		"Extra":   {{Time: "2026-03-02T11:00:00Z", Response: "snake_case"}},
+	}}
+	want := "## 2026-03-02\n\n" +
+		"### Done?\n\n" +
+		"- 10:00 pinned\n" +
+		"- 09:00 fixed \\*the\\* \\[bug\\]\n\n" +
+		"### Extra\n\n" +
+		"- 11:00 snake\\_case\n\n"
+	if got := renderMarkdown(log, []string{"Done?", "Unused?"}, settings); got != want {
+		t.Fatalf("renderMarkdown:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestViewMarkdownFlag(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Q", "first", "second"))
+	out, err := runOutput(t, "view", "--markdown", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"## 2026-03-02\n", "### Q\n", "- 09:00 first\n", "- 10:00 second\n"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("view --markdown missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "[09:00]") {
+		t.Fatalf("view --markdown printed the plain layout:\n%s", out)
+	}
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MinMood = 1
+	MaxMood = 5
+)
+
+func RunMood(args []string, settings Settings, dryRun bool) error {
+	if len(args) > 0 && args[0] == "--trend" {
+		opts, err := ParseViewArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		return RunMoodTrend(opts)
+	}
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: wlog mood <%d-%d> [date] or wlog mood --trend [interval]", MinMood, MaxMood)
+	}
+	mood, err := parseMood(args[0])
+	if err != nil {
+		return err
+	}
+	day := DayFloor(time.Now())
+	if len(args) == 2 {
+		day, err = parseAddDate(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD or a single day like \"yesterday\"", args[1])
+		}
+	}
+
+	if dryRun {
+		path, err := DayFilePath(day)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Dry run: would set mood %d/%d in %s\n", mood, MaxMood, path)
+		return nil
+	}
+	if err := SetDayMood(day, mood, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Set mood to %d/%d on %s.\n", mood, MaxMood, day.Format("2006-01-02"))
+	return nil
+}
+
+func parseMood(value string) (int, error) {
+	mood, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || ValidateMood(mood) != nil {
+		return 0, fmt.Errorf("invalid mood %q, expected a number from %d to %d", value, MinMood, MaxMood)
+	}
+	return mood, nil
+}
+
+type dayMood struct {
+	Date string
+	Mood int
+}
+
+// RunMoodTrend prints each rated day in the interval with a sparkline and the
+// average. Days without a mood are skipped rather than counted as zero.
+func RunMoodTrend(opts ViewOptions) error {
+	start, end, err := opts.bounds()
+	if err != nil {
+		return err
+	}
+	var moods []dayMood
+	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
+		entry, err := opts.readDay(cursor)
+		if err != nil {
+			return err
+		}
+		if entry == nil || entry.Mood == 0 {
+			continue
+		}
+		moods = append(moods, dayMood{Date: cursor.Format("2006-01-02"), Mood: entry.Mood})
+	}
+	if len(moods) == 0 {
+		fmt.Printf("No moods recorded for %s.\n", opts.label())
+		return nil
+	}
+
+	values := make([]int, len(moods))
+	for i, dm := range moods {
+		values[i] = dm.Mood
+		fmt.Printf("%s  %d %s\n", dm.Date, dm.Mood, moodSparkline([]int{dm.Mood}))
+	}
+	fmt.Printf("\nTrend:   %s\n", moodSparkline(values))
+	fmt.Printf("Average: %.2f over %d %s\n", moodAverage(values), len(values), pluralize(len(values), "day", "days"))
+	return nil
+}
+
+var moodBlocks = []rune{'▁', '▃', '▄', '▆', '█'}
+
+// moodSparkline maps each mood from 1 to 5 onto a block character of
+// increasing height. Out-of-range values render as a space.
+func moodSparkline(moods []int) string {
+	runes := make([]rune, len(moods))
+	for i, mood := range moods {
+		if ValidateMood(mood) != nil {
+			runes[i] = ' '
+			continue
+		}
+		runes[i] = moodBlocks[mood-MinMood]
+	}
+	return string(runes)
+}
+
+func moodAverage(moods []int) float64 {
+	if len(moods) == 0 {
+		return 0
+	}
+	total := 0
+	for _, mood := range moods {
+		total += mood
+	}
+	return float64(total) / float64(len(moods))
+}
+
+// ValidateMood reports whether mood is a rating wlog can store. Zero is not
+// valid here; it means "no mood" and is only written by clearing it.
+func ValidateMood(mood int) error {
+	if mood < MinMood || mood > MaxMood {
+		return fmt.Errorf("mood must be between %d and %d", MinMood, MaxMood)
+	}
+	return nil
+}
+
+// SetDayMood stores mood on the day file for day, creating it if needed.
+// A mood of 0 clears the rating.
+func SetDayMood(day time.Time, mood int, settings Settings) error {
+	if mood != 0 {
+		if err := ValidateMood(mood); err != nil {
+			return err
+		}
+	}
+	existing, err := ReadDayLogIfExists(day)
+	if err != nil {
+		return err
+	}
+	log := DayLog{Answers: make(map[string][]Answer)}
+	if existing != nil {
+		log = *existing
+	}
+	log.Mood = mood
+	return SaveDayLog(day, log, settings)
+}
+
+// FormatMood renders a stored mood as "Mood: 4/5", or "" when unset.
+func FormatMood(mood int) string {
+	if mood == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Mood: %d/%d", mood, MaxMood)
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseMood(t *testing.T) {
+	for _, value := range []string{"1", " 3 ", "5"} {
+		if _, err := parseMood(value); err != nil {
+			t.Errorf("parseMood(%q) = %v", value, err)
+		}
+	}
+	for _, value := range []string{"0", "6", "-1", "four", ""} {
+		if _, err := parseMood(value); err == nil {
+			t.Errorf("parseMood(%q) accepted an invalid mood", value)
+		}
+	}
+}
+
+func TestSetDayMoodPersists(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-02T09:00:00Z", Response: "a"}}}})
+
+	if err := SetDayMood(day, 4, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+	log, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log.Mood != 4 || len(log.Answers["Q"]) != 1 {
+		t.Fatalf("mood not saved alongside answers: %+v", log)
+	}
+	if err := SetDayMood(day, 9, Settings{}); err == nil {
+		t.Fatal("SetDayMood accepted 9")
+	}
+	if err := SetDayMood(day, 0, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDayFile(t, day); strings.Contains(got, "mood") {
+		t.Fatalf("cleared mood still written:\n%s", got)
+	}
+}
+
+func TestRunMoodCreatesDayFile(t *testing.T) {
+	useTempDirs(t)
+	out := captureStdout(t, func() {
+		if err := RunMood([]string{"3", "2026-03-02"}, Settings{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Set mood to 3/5 on 2026-03-02") {
+		t.Fatalf("output = %q", out)
+	}
+	log, err := LoadDayLog(mustDay(t, "2026-03-02"))
+	if err != nil || log.Mood != 3 {
+		t.Fatalf("mood = %d, err = %v", log.Mood, err)
+	}
+}
+
+func TestViewShowsMood(t *testing.T) {
+	useTempDirs(t)
+	today := DayFloor(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+	writeDay(t, today, DayLog{Mood: 4, Answers: map[string][]Answer{"Q": {{Time: today.Add(9 * time.Hour).Format(time.RFC3339), Response: "work"}}}})
+	writeDay(t, yesterday, DayLog{Mood: 2})
+
+	out := captureStdout(t, func() {
+		if err := RunView(ViewOptions{Interval: "last 2 days"}, []string{"Q"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Mood: 4/5") {
+		t.Errorf("view lost the mood of a day with answers:\n%s", out)
+	}
+	if !strings.Contains(out, yesterday.Format("2006-01-02")+"\n  Mood: 2/5") {
+		t.Errorf("view lost the mood-only day:\n%s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunView(ViewOptions{Interval: "last 2 days", Empty: true}, []string{"Q"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if strings.Contains(out, "no entries") || !strings.Contains(out, "Mood: 2/5") {
+		t.Errorf("--empty hid the mood-only day:\n%s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunCat(ViewOptions{Interval: "last 2 days"}, []string{"Q"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Mood: 2/5") || !strings.Contains(out, "Mood: 4/5") {
+		t.Errorf("cat dropped a mood:\n%s", out)
+	}
+}
+
+func TestFormatMood(t *testing.T) {
+	if got := FormatMood(0); got != "" {
+		t.Errorf("FormatMood(0) = %q", got)
+	}
+	if got := FormatMood(5); got != "Mood: 5/5" {
+		t.Errorf("FormatMood(5) = %q", got)
+	}
+}
+
+func TestMoodSparkline(t *testing.T) {
+	if got := moodSparkline([]int{1, 2, 3, 4, 5}); got != "▁▃▄▆█" {
+		t.Errorf("sparkline 1-5 = %q", got)
+	}
+	if got := moodSparkline([]int{0, 6, 3}); got != "  ▄" {
+		t.Errorf("sparkline with out-of-range moods = %q", got)
+	}
+	if got := moodSparkline(nil); got != "" {
+		t.Errorf("empty sparkline = %q", got)
+	}
+}
+
+func TestMoodAverage(t *testing.T) {
+	tests := []struct {
+		moods []int
+		want  float64
+	}{
+		{nil, 0},
+		{[]int{4}, 4},
+		{[]int{2, 5}, 3.5},
+		{[]int{1, 2, 2}, 5.0 / 3},
+	}
+	for _, tt := range tests {
+		if got := moodAverage(tt.moods); got != tt.want {
+			t.Errorf("moodAverage(%v) = %v, want %v", tt.moods, got, tt.want)
+		}
+	}
+}
+
+func TestRunMoodTrendSkipsUnratedDays(t *testing.T) {
+	useTempDirs(t)
+	for date, mood := range map[string]int{"2026-03-01": 2, "2026-03-02": 0, "2026-03-03": 5} {
+		day := mustDay(t, date)
+		log := dayWith(day, "Q", "worked")
+		log.Mood = mood
+		writeDay(t, day, log)
+	}
+	out, err := runOutput(t, "mood", "--trend", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "2026-03-01  2 ▃\n" +
+		"2026-03-03  5 █\n" +
+		"\nTrend:   ▃█\n" +
+		"Average: 3.50 over 2 days\n"
+	if out != want {
+		t.Fatalf("mood --trend:\n%s\nwant:\n%s", out, want)
+	}
+	if out, _ := runOutput(t, "mood", "--trend", "tomorrow"); out != "No moods recorded for tomorrow.\n" {
+		t.Fatalf("empty trend output = %q", out)
+	}
+}
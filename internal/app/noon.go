@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type answerBucket struct {
+	Label   string
+	Answers []Answer
+}
+
+// splitAtNoon groups answers into AM and PM by their timestamp, keeping their
+// order within each group. Answers whose time cannot be parsed go under
+// Unknown. Empty groups are omitted.
+func splitAtNoon(answers []Answer, settings Settings) []answerBucket {
+	buckets := []answerBucket{{Label: "AM"}, {Label: "PM"}, {Label: "Unknown"}}
+	for _, ans := range answers {
+		idx := 2
+		if t, err := time.Parse(time.RFC3339, ans.Time); err == nil {
+			idx = 0
+			if settings.clock(t).Hour() >= 12 {
+				idx = 1
+			}
+		}
+		buckets[idx].Answers = append(buckets[idx].Answers, ans)
+	}
+	kept := buckets[:0]
+	for _, bucket := range buckets {
+		if len(bucket.Answers) > 0 {
+			kept = append(kept, bucket)
+		}
+	}
+	return kept
+}
+
+// formatAnswers renders a question's answers as bullet lines at the given
+// indent, under AM/PM sub-headers when splitNoon is set. Pinned answers are
+// listed first and marked with an asterisk.
+func formatAnswers(answers []Answer, indent string, splitNoon bool, settings Settings) string {
+	var b strings.Builder
+	if !splitNoon {
+		for _, idx := range PinnedOrder(answers) {
+			ans := answers[idx]
+			marker := ""
+			if ans.Pinned {
+				marker = "* "
+			}
+			b.WriteString(fmt.Sprintf("%s- %s[%s] %s\n", indent, marker, settings.DisplayTime(ans.Time), ans.Response))
+		}
+		return b.String()
+	}
+	for _, bucket := range splitAtNoon(answers, settings) {
+		b.WriteString(fmt.Sprintf("%s%s\n", indent, bucket.Label))
+		b.WriteString(formatAnswers(bucket.Answers, indent+"  ", false, settings))
+	}
+	return b.String()
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitAtNoon(t *testing.T) {
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	answers := []Answer{
+		{Time: "2026-03-02T11:59:59Z", Response: "before"},
+		{Time: "2026-03-02T12:00:00Z", Response: "noon"},
+		{Time: "2026-03-02T00:00:00Z", Response: "midnight"},
+		{Time: "later", Response: "unparsed"},
+		{Time: "2026-03-02T23:59:00Z", Response: "late"},
+	}
+	var got [][]string
+	for _, bucket := range splitAtNoon(answers, settings) {
+		group := []string{bucket.Label}
+		for _, ans := range bucket.Answers {
+			group = append(group, ans.Response)
+		}
+		got = append(got, group)
+	}
+	want := [][]string{{"AM", "before", "midnight"}, {"PM", "noon", "late"}, {"Unknown", "unparsed"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("buckets = %v, want %v", got, want)
+	}
+
+	if buckets := splitAtNoon(answers[:1], settings); len(buckets) != 1 || buckets[0].Label != "AM" {
+		t.Fatalf("empty buckets kept: %+v", buckets)
+	}
+	// Noon is taken in the display zone, not in UTC.
+	tokyo := Config{DisplayTimezone: "Asia/Tokyo"}.Settings()
+	if buckets := splitAtNoon(answers[:1], tokyo); buckets[0].Label != "PM" {
+		t.Fatalf("11:59 UTC in Tokyo bucketed as %s, want PM", buckets[0].Label)
+	}
+}
+
+func TestFormatAnswersSplitNoon(t *testing.T) {
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	answers := []Answer{
+		{Time: "2026-03-02T09:00:00Z", Response: "plan"},
+		{Time: "2026-03-02T18:00:00Z", Response: "recap"},
+	}
+	want := "  AM\n    - [09:00] plan\n  PM\n    - [18:00] recap\n"
+	if got := formatAnswers(answers, "  ", true, settings); got != want {
+		t.Fatalf("formatAnswers:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatAnswersListsPinnedFirst(t *testing.T) {
+	answers := []Answer{
+		{Time: "2026-03-02T09:00:00Z", Response: "a"},
+		{Time: "2026-03-02T10:00:00Z", Response: "b", Pinned: true},
+		{Time: "2026-03-02T11:00:00Z", Response: "c"},
+		{Time: "2026-03-02T12:00:00Z", Response: "d", Pinned: true},
+	}
+	if got := PinnedOrder(answers); !reflect.DeepEqual(got, []int{1, 3, 0, 2}) {
+		t.Fatalf("PinnedOrder = %v, want [1 3 0 2]", got)
+	}
+	settings := Config{DisplayTimezone: "UTC"}.Settings()
+	want := "- * [10:00] b\n- * [12:00] d\n- [09:00] a\n- [11:00] c\n"
+	if got := formatAnswers(answers, "", false, settings); got != want {
+		t.Fatalf("formatAnswers:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPinnedRoundTrip(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "plain", "pinned")
+	log.Answers["Q"][1].Pinned = true
+	if err := SaveDayLog(day, log, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+	if data := readDayFile(t, day); strings.Count(data, `"pinned": true`) != 1 || strings.Contains(data, `"pinned": false`) {
+		t.Fatalf("pinned flag should be written only for the pinned entry:\n%s", data)
+	}
+	reloaded, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.Answers["Q"]; got[0].Pinned || !got[1].Pinned {
+		t.Fatalf("pinned flag lost: %+v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+type commandStarter interface {
+	Start(name string, args ...string) error
+}
+
+type execStarter struct{}
+
+func (execStarter) Start(name string, args ...string) error {
+	return exec.Command(name, args...).Start()
+}
+
+var starter commandStarter = execStarter{}
+
+func RunOpen(args []string) error {
+	if len(args) > 0 && args[0] == "config" {
+		path, err := ConfigFilePath()
+		if err != nil {
+			return err
+		}
+		return revealPath(path)
+	}
+
+	dir, err := DataDir()
+	if err != nil {
+		return err
+	}
+	if err := EnsureDir(dir); err != nil {
+		return err
+	}
+	return openPath(dir)
+}
+
+func openPath(path string) error {
+	name, args := openCommand(runtime.GOOS, path, false)
+	return starter.Start(name, args...)
+}
+
+func revealPath(path string) error {
+	name, args := openCommand(runtime.GOOS, path, true)
+	return starter.Start(name, args...)
+}
+
+// openCommand returns the platform command that opens path. When reveal is
+// set the file is selected in its parent folder where the platform supports
+// it; xdg-open cannot select files, so it opens the parent directory instead.
+func openCommand(goos, path string, reveal bool) (string, []string) {
+	switch goos {
+	case "darwin":
+		if reveal {
+			return "open", []string{"-R", path}
+		}
+		return "open", []string{path}
+	case "windows":
+		if reveal {
+			return "explorer", []string{"/select," + path}
+		}
+		return "explorer", []string{path}
+	default:
+		if reveal {
+			return "xdg-open", []string{filepath.Dir(path)}
+		}
+		return "xdg-open", []string{path}
+	}
+}
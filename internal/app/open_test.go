@@ -0,0 +1,69 @@
+package app
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+type recordingStarter struct {
+	name string
+	args []string
+}
+
+func (s *recordingStarter) Start(name string, args ...string) error {
+	s.name, s.args = name, args
+	return nil
+}
+
+func TestOpenCommand(t *testing.T) {
+	path := filepath.Join("data", "wlog", "config.json")
+	tests := []struct {
+		goos   string
+		reveal bool
+		name   string
+		args   []string
+	}{
+		{"darwin", false, "open", []string{path}},
+		{"darwin", true, "open", []string{"-R", path}},
+		{"windows", false, "explorer", []string{path}},
+		{"windows", true, "explorer", []string{"/select," + path}},
+		{"linux", false, "xdg-open", []string{path}},
+		{"linux", true, "xdg-open", []string{filepath.Dir(path)}},
+		{"freebsd", false, "xdg-open", []string{path}},
+	}
+	for _, tt := range tests {
+		name, args := openCommand(tt.goos, path, tt.reveal)
+		if name != tt.name || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("openCommand(%s, reveal=%v) = %s %q, want %s %q", tt.goos, tt.reveal, name, args, tt.name, tt.args)
+		}
+	}
+}
+
+func TestRunOpen(t *testing.T) {
+	dataDir := useTempDirs(t)
+	rec := &recordingStarter{}
+	defer func(orig commandStarter) { starter = orig }(starter)
+	starter = rec
+
+	if err := RunOpen(nil); err != nil {
+		t.Fatal(err)
+	}
+	wantName, wantArgs := openCommand(runtime.GOOS, dataDir, false)
+	if rec.name != wantName || !reflect.DeepEqual(rec.args, wantArgs) {
+		t.Fatalf("started %s %q, want %s %q", rec.name, rec.args, wantName, wantArgs)
+	}
+
+	if err := RunOpen([]string{"config"}); err != nil {
+		t.Fatal(err)
+	}
+	cfgPath, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantName, wantArgs = openCommand(runtime.GOOS, cfgPath, true)
+	if rec.name != wantName || !reflect.DeepEqual(rec.args, wantArgs) {
+		t.Fatalf("started %s %q, want %s %q", rec.name, rec.args, wantName, wantArgs)
+	}
+}
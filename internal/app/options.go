@@ -0,0 +1,416 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GlobalOptions struct {
+	Editor string
+	DryRun bool
+	Quiet  bool
+	Config string
+	// SilentEmpty overrides the silentEmpty config option for the prompts
+	// when set.
+	SilentEmpty *bool
+}
+
+// ParseGlobalFlags reads the flags shared by every command from the front of
+// args and returns them alongside the remaining arguments. Parsing stops at
+// the first other argument, usually the subcommand, so a command's own
+// arguments are never taken as global flags; a "--" also ends them and is
+// dropped.
+func ParseGlobalFlags(args []string) (GlobalOptions, []string, error) {
+	var opts GlobalOptions
+	var err error
+	if opts.Quiet, err = envQuiet(); err != nil {
+		return opts, nil, err
+	}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return opts, args[i+1:], nil
+		}
+		name, _, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--dry-run":
+			value, err := boolFlagValue(arg)
+			if err != nil {
+				return opts, nil, err
+			}
+			opts.DryRun = value
+		case "--quiet":
+			value, err := boolFlagValue(arg)
+			if err != nil {
+				return opts, nil, err
+			}
+			opts.Quiet = value
+		case "--silent-empty":
+			value, err := boolFlagValue(arg)
+			if err != nil {
+				return opts, nil, err
+			}
+			opts.SilentEmpty = &value
+		case "--config":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, nil, err
+			}
+			opts.Config = value
+		case "--editor":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, nil, err
+			}
+			opts.Editor = value
+		default:
+			return opts, args[i:], nil
+		}
+	}
+	return opts, nil, nil
+}
+
+// boolFlagValue reads a boolean flag given bare, which means true, or as
+// --name=value with any value strconv.ParseBool accepts.
+func boolFlagValue(arg string) (bool, error) {
+	name, value, ok := strings.Cut(arg, "=")
+	if !ok {
+		return true, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s, expected true or false", value, name)
+	}
+	return parsed, nil
+}
+
+// envQuiet reads WLOG_QUIET, which takes any value strconv.ParseBool
+// accepts. Unset or empty means false.
+func envQuiet() (bool, error) {
+	value := strings.TrimSpace(os.Getenv("WLOG_QUIET"))
+	if value == "" {
+		return false, nil
+	}
+	quiet, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid WLOG_QUIET %q, expected true or false", value)
+	}
+	return quiet, nil
+}
+
+var quiet bool
+
+// SetQuiet controls whether Warnf prints. Errors are unaffected.
+func SetQuiet(value bool) {
+	quiet = value
+}
+
+// Warnf prints a non-fatal warning to stderr unless quiet mode is on.
+func Warnf(format string, args ...any) {
+	if quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
+type ViewOptions struct {
+	Interval            string
+	Days                int
+	Plain               bool
+	Empty               bool
+	SplitNoon           bool
+	Flat                bool
+	CountOnly           bool
+	NoEmptyQuestions    bool
+	Markdown            bool
+	Strict              bool
+	HTMLOpen            bool
+	Format              string
+	After               *int
+	Before              *int
+	NoEntriesMessage    string
+	GroupBy             string
+	IncludeArchived     bool
+	JSON                bool
+	JSONPretty          bool
+	OnlyConfigQuestions bool
+	SinceLast           bool
+	Oneline             bool
+	ReverseEntries      bool
+	Settings            Settings
+	since               *time.Time
+}
+
+func ParseViewArgs(args []string) (ViewOptions, error) {
+	var opts ViewOptions
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, _, _ := strings.Cut(arg, "=")
+		var flag *bool
+		switch name {
+		case "--plain":
+			flag = &opts.Plain
+		case "--empty":
+			flag = &opts.Empty
+		case "--split-noon":
+			flag = &opts.SplitNoon
+		case "--flat":
+			flag = &opts.Flat
+		case "--oneline":
+			flag = &opts.Oneline
+		case "--reverse-entries":
+			flag = &opts.ReverseEntries
+		case "--count-only":
+			flag = &opts.CountOnly
+		case "--no-empty-questions":
+			flag = &opts.NoEmptyQuestions
+		case "--markdown":
+			flag = &opts.Markdown
+		case "--strict":
+			flag = &opts.Strict
+		case "--include-archived":
+			flag = &opts.IncludeArchived
+		case "--only-today-questions", "--config-only":
+			flag = &opts.OnlyConfigQuestions
+		case "--since-last":
+			flag = &opts.SinceLast
+		case "--json":
+			flag = &opts.JSON
+		case "--json-pretty":
+			flag = &opts.JSONPretty
+		case "--html-open":
+			flag = &opts.HTMLOpen
+		case "--group-by":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			groupBy, err := parseGroupBy(value)
+			if err != nil {
+				return opts, err
+			}
+			opts.GroupBy = groupBy
+		case "--format":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			opts.Format = value
+		case "--days":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			days, err := strconv.Atoi(value)
+			if err != nil || days <= 0 {
+				return opts, fmt.Errorf("invalid --days value %q, expected a positive number", value)
+			}
+			opts.Days = days
+		case "--after", "--before":
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return opts, err
+			}
+			minutes, err := parseClock(value)
+			if err != nil {
+				return opts, fmt.Errorf("invalid %s time %q, expected HH:MM", name, value)
+			}
+			if name == "--after" {
+				opts.After = &minutes
+			} else {
+				opts.Before = &minutes
+			}
+		default:
+			if strings.HasPrefix(arg, "--") {
+				return opts, fmt.Errorf("unknown flag %q", arg)
+			}
+			positional = append(positional, arg)
+		}
+		if flag != nil {
+			value, err := boolFlagValue(arg)
+			if err != nil {
+				return opts, err
+			}
+			*flag = value
+		}
+	}
+	opts.Interval = strings.Join(positional, " ")
+	if opts.Days > 0 && opts.Interval != "" {
+		return opts, fmt.Errorf("--days cannot be combined with an interval (%q)", opts.Interval)
+	}
+	if opts.SinceLast && (opts.Days > 0 || opts.Interval != "") {
+		return opts, fmt.Errorf("--since-last cannot be combined with an interval or --days")
+	}
+	return opts, nil
+}
+
+func (opts ViewOptions) bounds() (time.Time, time.Time, error) {
+	if opts.SinceLast {
+		return opts.sinceLastBounds()
+	}
+	if opts.Days > 0 {
+		start, end := lastDaysRange(DayFloor(time.Now()), opts.Days)
+		return start, end, nil
+	}
+	return ParseInterval(opts.Interval)
+}
+
+func (opts ViewOptions) label() string {
+	if opts.SinceLast {
+		return "the time since your last view"
+	}
+	if opts.Days > 0 {
+		return fmt.Sprintf("last %d %s", opts.Days, pluralize(opts.Days, "day", "days"))
+	}
+	return intervalLabel(opts.Interval)
+}
+
+// sinceLastBounds runs from the day of the last view marker, or the oldest
+// day file when there is none, through today.
+func (opts ViewOptions) sinceLastBounds() (time.Time, time.Time, error) {
+	today := DayFloor(time.Now())
+	if opts.since != nil {
+		return DayFloor(opts.since.In(time.Local)), today, nil
+	}
+	paths, err := listDayFiles(opts.IncludeArchived)
+	if err != nil || len(paths) == 0 {
+		return today, today, err
+	}
+	first, _ := dayFromFileName(filepath.Base(paths[0]))
+	if first.After(today) {
+		return today, today, nil
+	}
+	return first, today, nil
+}
+
+// noEntriesMessage renders the configured empty-result message, substituting
+// the interval label for every %s. Other verbs are left as written.
+func (opts ViewOptions) noEntriesMessage() string {
+	message := opts.NoEntriesMessage
+	if message == "" {
+		message = defaultNoEntriesMessage
+	}
+	return strings.ReplaceAll(message, "%s", opts.label())
+}
+
+// flagValue returns the value for a flag given either as "--name=value" or as
+// "--name value", advancing i past the consumed argument in the latter case.
+func flagValue(args []string, i *int) (string, error) {
+	arg := args[*i]
+	if name, value, ok := strings.Cut(arg, "="); ok {
+		if value == "" {
+			return "", fmt.Errorf("missing value for %s", name)
+		}
+		return value, nil
+	}
+	if *i+1 >= len(args) {
+		return "", fmt.Errorf("missing value for %s", arg)
+	}
+	*i++
+	return args[*i], nil
+}
+
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// readDay loads one day of a range scan. Unless --strict is set, a day file
+// that fails to decode is reported on stderr and skipped so the rest of the
+// range still renders.
+func (opts ViewOptions) readDay(day time.Time) (*DayLog, error) {
+	entry, err := readDayLog(day, opts.IncludeArchived)
+	if err == nil {
+		return entry, nil
+	}
+	if opts.Strict || !isDecodeError(err) {
+		return nil, err
+	}
+	Warnf("skipping %v\n", err)
+	return nil, nil
+}
+
+func isDecodeError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+func (opts ViewOptions) hasTimeWindow() bool {
+	return opts.After != nil || opts.Before != nil || opts.since != nil
+}
+
+// inTimeWindow reports whether an RFC3339 timestamp is not before the
+// --since-last marker and its clock component falls inside the
+// --after/--before window. Both clock bounds are inclusive, and an after
+// bound later than the before bound wraps around midnight.
+func (opts ViewOptions) inTimeWindow(value string) bool {
+	if !opts.hasTimeWindow() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	if opts.since != nil && t.Before(*opts.since) {
+		return false
+	}
+	if opts.After == nil && opts.Before == nil {
+		return true
+	}
+	t = opts.Settings.clock(t)
+	minutes := t.Hour()*60 + t.Minute()
+	switch {
+	case opts.After != nil && opts.Before != nil:
+		if *opts.After <= *opts.Before {
+			return minutes >= *opts.After && minutes <= *opts.Before
+		}
+		return minutes >= *opts.After || minutes <= *opts.Before
+	case opts.After != nil:
+		return minutes >= *opts.After
+	default:
+		return minutes <= *opts.Before
+	}
+}
+
+// entryOrder returns answers in the order a view lists them: as stored, or
+// newest first with --reverse-entries. The stored slice is never reordered.
+func (opts ViewOptions) entryOrder(answers []Answer) []Answer {
+	if !opts.ReverseEntries {
+		return answers
+	}
+	reversed := make([]Answer, len(answers))
+	for i, ans := range answers {
+		reversed[len(answers)-1-i] = ans
+	}
+	return reversed
+}
+
+func (opts ViewOptions) filterDayLog(log DayLog) DayLog {
+	if !opts.hasTimeWindow() {
+		return log
+	}
+	filtered := DayLog{Date: log.Date, Mood: log.Mood, Answers: make(map[string][]Answer, len(log.Answers))}
+	for q, answers := range log.Answers {
+		var kept []Answer
+		for _, ans := range answers {
+			if opts.inTimeWindow(ans.Time) {
+				kept = append(kept, ans)
+			}
+		}
+		if len(kept) > 0 {
+			filtered.Answers[q] = kept
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,334 @@
+package app
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseGlobalFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want GlobalOptions
+		rest []string
+		err  string
+	}{
+		{"none", nil, GlobalOptions{}, nil, ""},
+		{"command only", []string{"add", "0", "text"}, GlobalOptions{}, []string{"add", "0", "text"}, ""},
+		{
+			name: "before the command",
+			args: []string{"--dry-run", "--quiet", "--config", "c.yaml", "--editor=vim", "rm", "today"},
+			want: GlobalOptions{DryRun: true, Quiet: true, Config: "c.yaml", Editor: "vim"},
+			rest: []string{"rm", "today"},
+		},
+		{
+			name: "after the command",
+			args: []string{"add", "0", "--dry-run", "--config", "x"},
+			rest: []string{"add", "0", "--dry-run", "--config", "x"},
+		},
+		{
+			name: "entry text that looks like a flag",
+			args: []string{"--quiet", "add", "0", "--quiet"},
+			want: GlobalOptions{Quiet: true},
+			rest: []string{"add", "0", "--quiet"},
+		},
+		{
+			name: "double dash",
+			args: []string{"--dry-run", "--", "--quiet"},
+			want: GlobalOptions{DryRun: true},
+			rest: []string{"--quiet"},
+		},
+		{
+			name: "command flag first",
+			args: []string{"--set", "0=a", "--dry-run"},
+			rest: []string{"--set", "0=a", "--dry-run"},
+		},
+		{"silent empty", []string{"--silent-empty", "--dry-run"}, GlobalOptions{DryRun: true, SilentEmpty: boolPtr(true)}, nil, ""},
+		{"silent empty off", []string{"--dry-run", "--silent-empty=false"}, GlobalOptions{DryRun: true, SilentEmpty: boolPtr(false)}, nil, ""},
+		{"explicit false", []string{"--dry-run=false", "--quiet=0"}, GlobalOptions{}, nil, ""},
+		{"explicit true", []string{"--dry-run=true", "--quiet=1"}, GlobalOptions{DryRun: true, Quiet: true}, nil, ""},
+		{"invalid bool", []string{"--dry-run=maybe"}, GlobalOptions{}, nil, `invalid value "maybe" for --dry-run`},
+		{"missing value", []string{"--config"}, GlobalOptions{}, nil, "missing value for --config"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WLOG_QUIET", "")
+			got, rest, err := ParseGlobalFlags(tt.args)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("options = %+v, want %+v", got, tt.want)
+			}
+			if len(rest) != 0 || len(tt.rest) != 0 {
+				if !reflect.DeepEqual(rest, tt.rest) {
+					t.Errorf("rest = %q, want %q", rest, tt.rest)
+				}
+			}
+		})
+	}
+}
+
+func TestRunDryRunFalseWrites(t *testing.T) {
+	useTempDirs(t)
+	captureStdout(t, func() {
+		if err := Run([]string{"--dry-run=false", "add", "--question-text", "Q", "written"}, BuildInfo{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out := captureStdout(t, func() { _ = Run([]string{"grep", "written"}, BuildInfo{}) }); !strings.Contains(out, "written") {
+		t.Fatalf("--dry-run=false did not write the entry:\n%s", out)
+	}
+}
+
+func TestParseGlobalFlagsQuietEnv(t *testing.T) {
+	tests := []struct {
+		env   string
+		args  []string
+		quiet bool
+		err   bool
+	}{
+		{"", nil, false, false},
+		{"1", nil, true, false},
+		{"true", nil, true, false},
+		{" TRUE ", nil, true, false},
+		{"0", nil, false, false},
+		{"false", nil, false, false},
+		{"yes", nil, false, true},
+		{"off", nil, false, true},
+		{"1", []string{"--quiet=false"}, false, false},
+		{"0", []string{"--quiet"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Setenv("WLOG_QUIET", tt.env)
+		got, _, err := ParseGlobalFlags(tt.args)
+		if (err != nil) != tt.err {
+			t.Errorf("WLOG_QUIET=%q %v: error = %v, want error %v", tt.env, tt.args, err, tt.err)
+			continue
+		}
+		if err == nil && got.Quiet != tt.quiet {
+			t.Errorf("WLOG_QUIET=%q %v: Quiet = %v, want %v", tt.env, tt.args, got.Quiet, tt.quiet)
+		}
+	}
+}
+
+func TestTimeOfDayWindow(t *testing.T) {
+	day := mustDay(t, "2026-03-02")
+	at := func(h, m int) string {
+		return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute).Format(time.RFC3339)
+	}
+	tests := []struct {
+		args  []string
+		value string
+		want  bool
+	}{
+		{[]string{"--after", "13:00", "--before", "18:00"}, at(13, 0), true},
+		{[]string{"--after", "13:00", "--before", "18:00"}, at(18, 0), true},
+		{[]string{"--after", "13:00", "--before", "18:00"}, at(12, 59), false},
+		{[]string{"--after", "13:00", "--before", "18:00"}, at(18, 1), false},
+		{[]string{"--after", "22:00", "--before", "02:00"}, at(23, 30), true},
+		{[]string{"--after", "22:00", "--before", "02:00"}, at(1, 0), true},
+		{[]string{"--after", "22:00", "--before", "02:00"}, at(2, 0), true},
+		{[]string{"--after", "22:00", "--before", "02:00"}, at(12, 0), false},
+		{[]string{"--after", "9:30"}, at(9, 30), true},
+		{[]string{"--after", "9:30"}, at(9, 29), false},
+		{[]string{"--before", "09:30"}, at(9, 31), false},
+		{[]string{"--after", "13:00"}, "13:30", false},
+		{nil, "13:30", true},
+	}
+	for _, tt := range tests {
+		opts, err := ParseViewArgs(tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := opts.inTimeWindow(tt.value); got != tt.want {
+			t.Errorf("%v: inTimeWindow(%q) = %v, want %v", tt.args, tt.value, got, tt.want)
+		}
+	}
+	for _, args := range [][]string{{"--after", "25:00"}, {"--before", "noon"}, {"--after"}} {
+		if _, err := ParseViewArgs(args); err == nil {
+			t.Errorf("%v: expected an error", args)
+		}
+	}
+}
+
+func TestViewTimeOfDayRange(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	log := DayLog{Answers: map[string][]Answer{"Q": {
+		{Time: today.Add(9 * time.Hour).Format(time.RFC3339), Response: "morning"},
+		{Time: today.Add(14 * time.Hour).Format(time.RFC3339), Response: "afternoon"},
+		{Time: "whenever", Response: "undated"},
+	}}}
+	writeDay(t, today, log)
+	out, err := runOutput(t, "view", "--after", "13:00", "--before", "18:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "afternoon") || strings.Contains(out, "morning") || strings.Contains(out, "undated") {
+		t.Fatalf("view outside the window:\n%s", out)
+	}
+}
+
+func TestViewDaysFlag(t *testing.T) {
+	today := DayFloor(time.Now())
+	tests := []struct {
+		args       []string
+		start, end time.Time
+	}{
+		{[]string{"--days", "1"}, today, today},
+		{[]string{"--days=7"}, today.AddDate(0, 0, -6), today},
+	}
+	for _, tt := range tests {
+		opts, err := ParseViewArgs(tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		start, end, err := opts.bounds()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Errorf("%v: bounds = %s..%s, want %s..%s", tt.args, start, end, tt.start, tt.end)
+		}
+	}
+
+	seven, _ := ParseViewArgs([]string{"--days", "7"})
+	start, end, _ := seven.bounds()
+	wantStart, wantEnd, err := ParseInterval("last 7 days")
+	if err != nil || !start.Equal(wantStart) || !end.Equal(wantEnd) {
+		t.Errorf("--days 7 = %s..%s, last 7 days = %s..%s (%v)", start, end, wantStart, wantEnd, err)
+	}
+
+	for _, args := range [][]string{{"--days", "7", "yesterday"}, {"this", "week", "--days=2"}, {"--days", "0"}, {"--days", "x"}, {"--days"}} {
+		if _, err := ParseViewArgs(args); err == nil {
+			t.Errorf("%v: expected an error", args)
+		}
+	}
+}
+
+func TestParseViewArgsBoolValues(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"--plain"}, true},
+		{[]string{"--plain=true"}, true},
+		{[]string{"--plain=false"}, false},
+		{[]string{"--plain", "--plain=0"}, false},
+	}
+	for _, tt := range tests {
+		opts, err := ParseViewArgs(tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if opts.Plain != tt.want {
+			t.Errorf("%v: Plain = %v, want %v", tt.args, opts.Plain, tt.want)
+		}
+	}
+
+	opts, err := ParseViewArgs([]string{"--json=false", "--count-only=false", "--since-last=false", "--empty=true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.JSON || opts.CountOnly || opts.SinceLast || !opts.Empty {
+		t.Errorf("explicit values ignored: %+v", opts)
+	}
+	if _, err := ParseViewArgs([]string{"--plain=maybe"}); err == nil {
+		t.Error("--plain=maybe: expected an error")
+	}
+}
+
+func TestQuietSuppressesWarnings(t *testing.T) {
+	t.Cleanup(func() { SetQuiet(false) })
+	tests := []struct {
+		name  string
+		env   string
+		flags []string
+		quiet bool
+	}{
+		{"default", "", nil, false},
+		{"flag", "", []string{"--quiet"}, true},
+		{"env", "1", nil, true},
+		{"flag overrides env", "true", []string{"--quiet=false"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			path, err := ConfigFilePath()
+			if err != nil {
+				t.Fatal(err)
+			}
+			writeFile(t, path, "{not json")
+			t.Setenv("WLOG_QUIET", tt.env)
+
+			stderr := captureStderr(t, func() {
+				captureStdout(t, func() {
+					if err := Run(append(tt.flags, "ls"), BuildInfo{}); err != nil {
+						t.Fatal(err)
+					}
+				})
+			})
+			if warned := strings.Contains(stderr, "using default questions"); warned == tt.quiet {
+				t.Fatalf("quiet %v, but stderr = %q", tt.quiet, stderr)
+			}
+
+			// Real errors are still returned in quiet mode.
+			var runErr error
+			captureStderr(t, func() {
+				captureStdout(t, func() {
+					runErr = Run(append(tt.flags, "questions"), BuildInfo{})
+				})
+			})
+			if ExitCode(runErr) != ExitConfig {
+				t.Fatalf("invalid config error = %v, want a config error", runErr)
+			}
+		})
+	}
+}
+
+func TestNoEntriesMessage(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"", "No entries found for tomorrow."},
+		{"Nichts für %s.", "Nichts für tomorrow."},
+		{"%s: empty (%s)", "tomorrow: empty (tomorrow)"},
+		{"Nothing logged, 100%d done", "Nothing logged, 100%d done"},
+	}
+	for _, tt := range tests {
+		opts := ViewOptions{Interval: "tomorrow", NoEntriesMessage: tt.message}
+		if got := opts.noEntriesMessage(); got != tt.want {
+			t.Errorf("noEntriesMessage(%q) = %q, want %q", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestViewUsesConfiguredNoEntriesMessage(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}, NoEntriesMessage: "Nothing logged for %s yet."}); err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range []string{"view", "cat"} {
+		out, err := runOutput(t, cmd, "tomorrow")
+		if !errors.Is(err, ErrNoEntries) {
+			t.Fatalf("%s: err = %v, want ErrNoEntries", cmd, err)
+		}
+		if out != "Nothing logged for tomorrow yet.\n" {
+			t.Fatalf("%s output = %q", cmd, out)
+		}
+	}
+}
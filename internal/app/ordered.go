@@ -0,0 +1,87 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OrderedDayLog is the list form of a day file, written when orderedAnswers
+// is on. Its answers are an array of {question, answers} objects in question
+// order instead of an object keyed by question, so the order survives tools
+// that re-sort object keys. DayLog reads either form.
+type OrderedDayLog struct {
+	SchemaVersion int               `json:"schemaVersion,omitempty"`
+	Date          string            `json:"date"`
+	Answers       []QuestionAnswers `json:"answers"`
+	Mood          int               `json:"mood,omitempty"`
+	Trash         []TrashedAnswer   `json:"trash,omitempty"`
+}
+
+type QuestionAnswers struct {
+	Question string   `json:"question"`
+	Answers  []Answer `json:"answers"`
+}
+
+// Ordered converts log to the list form, with questions in order followed by
+// the rest sorted.
+func (log DayLog) Ordered(order []string) OrderedDayLog {
+	ordered := OrderedDayLog{
+		SchemaVersion: log.SchemaVersion,
+		Date:          log.Date,
+		Answers:       make([]QuestionAnswers, 0, len(log.Answers)),
+		Mood:          log.Mood,
+		Trash:         log.Trash,
+	}
+	for _, q := range OrderQuestions(log.Answers, order) {
+		ordered.Answers = append(ordered.Answers, QuestionAnswers{Question: q, Answers: log.Answers[q]})
+	}
+	return ordered
+}
+
+// DayLog converts the list form back to the map form. A question listed more
+// than once has its answers merged in list order.
+func (ordered OrderedDayLog) DayLog() DayLog {
+	return DayLog{
+		SchemaVersion: ordered.SchemaVersion,
+		Date:          ordered.Date,
+		Answers:       questionAnswersMap(ordered.Answers),
+		Mood:          ordered.Mood,
+		Trash:         ordered.Trash,
+	}
+}
+
+func questionAnswersMap(list []QuestionAnswers) map[string][]Answer {
+	answers := make(map[string][]Answer, len(list))
+	for _, qa := range list {
+		answers[qa.Question] = append(answers[qa.Question], qa.Answers...)
+	}
+	return answers
+}
+
+// UnmarshalJSON accepts answers either as an object keyed by question or in
+// the list form written by OrderedDayLog.
+func (log *DayLog) UnmarshalJSON(data []byte) error {
+	var stored storedDayLog
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	*log = DayLog{
+		SchemaVersion: stored.SchemaVersion,
+		Date:          stored.Date,
+		Mood:          stored.Mood,
+		Trash:         stored.Trash,
+	}
+	raw := bytes.TrimSpace(stored.Answers)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+	if raw[0] == '[' {
+		var list []QuestionAnswers
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		log.Answers = questionAnswersMap(list)
+		return nil
+	}
+	return json.Unmarshal(raw, &log.Answers)
+}
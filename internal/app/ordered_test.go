@@ -0,0 +1,125 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDayLogUnmarshalShapes(t *testing.T) {
+	want := map[string][]Answer{
+		"A": {{Time: "2026-03-02T09:00:00Z", Response: "a1"}, {Time: "2026-03-02T10:00:00Z", Response: "a2"}},
+		"B": {{Time: "2026-03-02T11:00:00Z", Response: "b"}},
+	}
+	tests := []struct {
+		name string
+		data string
+		want map[string][]Answer
+	}{
+		{
+			name: "map",
+			data: `{"date":"2026-03-02","answers":{"A":[{"time":"2026-03-02T09:00:00Z","response":"a1"},{"time":"2026-03-02T10:00:00Z","response":"a2"}],"B":[{"time":"2026-03-02T11:00:00Z","response":"b"}]}}`,
+			want: want,
+		},
+		{
+			name: "list",
+			data: `{"date":"2026-03-02","answers":[{"question":"B","answers":[{"time":"2026-03-02T11:00:00Z","response":"b"}]},{"question":"A","answers":[{"time":"2026-03-02T09:00:00Z","response":"a1"},{"time":"2026-03-02T10:00:00Z","response":"a2"}]}]}`,
+			want: want,
+		},
+		{
+			name: "list with repeated question",
+			data: `{"date":"2026-03-02","answers":[{"question":"A","answers":[{"time":"2026-03-02T09:00:00Z","response":"a1"}]},{"question":"B","answers":[{"time":"2026-03-02T11:00:00Z","response":"b"}]},{"question":"A","answers":[{"time":"2026-03-02T10:00:00Z","response":"a2"}]}]}`,
+			want: want,
+		},
+		{
+			name: "null",
+			data: `{"date":"2026-03-02","answers":null}`,
+			want: nil,
+		},
+		{
+			name: "missing",
+			data: `{"date":"2026-03-02","mood":3}`,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var log DayLog
+			if err := json.Unmarshal([]byte(tt.data), &log); err != nil {
+				t.Fatal(err)
+			}
+			if log.Date != "2026-03-02" {
+				t.Errorf("Date = %q", log.Date)
+			}
+			if !reflect.DeepEqual(log.Answers, tt.want) {
+				t.Errorf("Answers = %v, want %v", log.Answers, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayLogUnmarshalRejectsBadAnswers(t *testing.T) {
+	var log DayLog
+	if err := json.Unmarshal([]byte(`{"date":"2026-03-02","answers":"nope"}`), &log); err == nil {
+		t.Fatal("expected an error for a string answers value")
+	}
+}
+
+func TestOrderedAnswersWriteIsStable(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	settings := Config{Questions: []string{"B", "A"}, OrderedAnswers: boolPtr(true)}.Settings()
+	log := DayLog{
+		Mood: 4,
+		Answers: map[string][]Answer{
+			"A":     {{Time: "2026-03-02T09:00:00Z", Response: "a"}},
+			"B":     {{Time: "2026-03-02T10:00:00Z", Response: "b"}},
+			"Extra": {{Time: "2026-03-02T11:00:00Z", Response: "x"}},
+		},
+		Trash: []TrashedAnswer{{Question: "A", DeletedAt: "2026-03-02T12:00:00Z", Answer: Answer{Time: "2026-03-02T08:00:00Z", Response: "gone"}}},
+	}
+	if err := SaveDayLog(day, log, settings); err != nil {
+		t.Fatal(err)
+	}
+	first := readDayFile(t, day)
+
+	var stored OrderedDayLog
+	if err := json.Unmarshal([]byte(first), &stored); err != nil {
+		t.Fatalf("list form did not decode as OrderedDayLog: %v\n%s", err, first)
+	}
+	var order []string
+	for _, qa := range stored.Answers {
+		order = append(order, qa.Question)
+	}
+	if want := []string{"B", "A", "Extra"}; !reflect.DeepEqual(order, want) {
+		t.Fatalf("question order = %v, want %v", order, want)
+	}
+
+	reloaded, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Mood != 4 || len(reloaded.Trash) != 1 || len(reloaded.Answers) != 3 {
+		t.Fatalf("round trip lost data: %+v", reloaded)
+	}
+	if err := SaveDayLog(day, reloaded, settings); err != nil {
+		t.Fatal(err)
+	}
+	if again := readDayFile(t, day); again != first {
+		t.Fatalf("second save differs:\n%s\nvs\n%s", first, again)
+	}
+}
+
+func TestOrderedDayLogRoundTrip(t *testing.T) {
+	log := DayLog{Date: "2026-03-02", Answers: map[string][]Answer{
+		"A": {{Time: "2026-03-02T09:00:00Z", Response: "a"}},
+		"B": nil,
+	}}
+	back := log.Ordered([]string{"B", "A"}).DayLog()
+	if !reflect.DeepEqual(back.Answers["A"], log.Answers["A"]) {
+		t.Fatalf("answers changed: %v", back.Answers)
+	}
+	if _, ok := back.Answers["B"]; !ok {
+		t.Fatalf("empty question dropped: %v", back.Answers)
+	}
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDataDirEnvOverride(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", filepath.Join(root, "xdg"))
+	t.Setenv("WLOG_DATA_DIR", filepath.Join(root, "explicit"))
+	if got, err := DataDir(); err != nil || got != filepath.Join(root, "explicit") {
+		t.Fatalf("DataDir() = %q, %v; want the WLOG_DATA_DIR path", got, err)
+	}
+
+	t.Setenv("WLOG_DATA_DIR", "")
+	if got, err := DataDir(); err != nil || got != filepath.Join(root, "xdg", "wlog") {
+		t.Fatalf("DataDir() = %q, %v; want the XDG path", got, err)
+	}
+}
+
+func TestConfigFilePathEnvOverride(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(root, "xdg"))
+	t.Setenv("WLOG_CONFIG_FILE", filepath.Join(root, "explicit.yaml"))
+	if got, err := ConfigFilePath(); err != nil || got != filepath.Join(root, "explicit.yaml") {
+		t.Fatalf("ConfigFilePath() = %q, %v; want the WLOG_CONFIG_FILE path", got, err)
+	}
+
+	SetConfigPath(filepath.Join(root, "flag.json"))
+	t.Cleanup(func() { SetConfigPath("") })
+	if got, err := ConfigFilePath(); err != nil || got != filepath.Join(root, "flag.json") {
+		t.Fatalf("ConfigFilePath() = %q, %v; want --config to win over WLOG_CONFIG_FILE", got, err)
+	}
+	SetConfigPath("")
+
+	t.Setenv("WLOG_CONFIG_FILE", "")
+	if got, err := ConfigFilePath(); err != nil || got != filepath.Join(root, "xdg", "wlog", "config.json") {
+		t.Fatalf("ConfigFilePath() = %q, %v; want the XDG path", got, err)
+	}
+}
+
+func TestDataDirIsAFile(t *testing.T) {
+	useTempDirs(t)
+	path := filepath.Join(t.TempDir(), "wlog")
+	writeFile(t, path, "oops")
+	t.Setenv("WLOG_DATA_DIR", path)
+
+	want := "data path exists as a file: " + path
+	if _, err := DataDir(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("DataDir() error = %v, want %q", err, want)
+	}
+	for _, args := range [][]string{{"add", "--question-text", "Q", "entry"}, {"view"}, {"ls"}} {
+		if _, err := runOutput(t, args...); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%v error = %v, want %q", args, err, want)
+		}
+	}
+	if err := EnsureDir(path); err == nil || !strings.Contains(err.Error(), "exists as a file") {
+		t.Fatalf("EnsureDir error = %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunPromptsSilentEmpty(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		config Config
+		silent bool
+	}{
+		{"default", nil, Config{}, false},
+		{"flag", []string{"--silent-empty"}, Config{}, true},
+		{"config", nil, Config{SilentEmpty: boolPtr(true)}, true},
+		{"config off", nil, Config{SilentEmpty: boolPtr(false)}, false},
+		{"flag before dry run", []string{"--silent-empty", "--dry-run"}, Config{}, true},
+		{"flag after dry run", []string{"--dry-run", "--silent-empty"}, Config{}, true},
+		{"flag overrides config", []string{"--silent-empty=false"}, Config{SilentEmpty: boolPtr(true)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataDir := useTempDirs(t)
+			tt.config.Questions = []string{"Q1", "Q2"}
+			if err := SaveConfig(tt.config); err != nil {
+				t.Fatal(err)
+			}
+			var out string
+			withStdin(t, "\n\n", func() {
+				out = captureStdout(t, func() {
+					if err := Run(tt.args, BuildInfo{}); err != nil {
+						t.Fatal(err)
+					}
+				})
+			})
+			if got := strings.Contains(out, "No entries recorded"); got == tt.silent {
+				t.Fatalf("silent %v, but output:\n%s", tt.silent, out)
+			}
+			if paths, _ := dayFilesIn(dataDir); len(paths) != 0 {
+				t.Fatalf("an empty run wrote %v", paths)
+			}
+		})
+	}
+}
+
+func TestSilentEmptyWithDryRun(t *testing.T) {
+	for _, args := range [][]string{{"--silent-empty", "--dry-run"}, {"--dry-run", "--silent-empty"}} {
+		dataDir := useTempDirs(t)
+		if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+			t.Fatal(err)
+		}
+		var out string
+		withStdin(t, "did X\n", func() {
+			out = captureStdout(t, func() {
+				if err := Run(args, BuildInfo{}); err != nil {
+					t.Fatalf("%v: %v", args, err)
+				}
+			})
+		})
+		if !strings.Contains(out, "Dry run") {
+			t.Fatalf("%v: no dry-run report:\n%s", args, out)
+		}
+		if paths, _ := dayFilesIn(dataDir); len(paths) != 0 {
+			t.Fatalf("%v wrote %v", args, paths)
+		}
+	}
+}
+
+func TestRunPromptsSilentEmptyStillReportsEntries(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Q1", "Q2"}}
+	var out string
+	withStdin(t, "done\n\n", func() {
+		out = captureStdout(t, func() {
+			if err := RunPrompts(cfg, false, true); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	if strings.Contains(out, "No entries recorded") {
+		t.Fatalf("unexpected empty message:\n%s", out)
+	}
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers["Q1"]) != 1 || log.Answers["Q1"][0].Response != "done" {
+		t.Fatalf("answers = %+v", log.Answers)
+	}
+}
+
+func TestRunPromptsWarnsOnLongAnswers(t *testing.T) {
+	tests := []struct {
+		name      string
+		warnLen   *int
+		softLimit *int
+		input     string
+		warn      string
+	}{
+		{"over the limit", intPtr(10), nil, "a much longer answer\n", "(that was 20 chars)"},
+		{"at the limit", intPtr(10), nil, "ten chars!\n", ""},
+		{"counts runes", intPtr(4), nil, "héllo\n", "(that was 5 chars)"},
+		{"off by default", nil, nil, "a much longer answer\n", ""},
+		{"falls back to the soft limit", nil, intPtr(10), "a much longer answer\n", "(that was 20 chars)"},
+		{"overrides the soft limit", intPtr(30), intPtr(10), "a much longer answer\n", ""},
+		{"zero turns it off", intPtr(0), intPtr(10), "a much longer answer\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			cfg := Config{Questions: []string{"Q"}, WarnResponseLen: tt.warnLen, EntrySoftLimitChars: tt.softLimit}
+			cfg.ensureDefaults()
+			var out string
+			withStdin(t, tt.input, func() {
+				out = captureStdout(t, func() {
+					if err := RunPrompts(cfg, false, false); err != nil {
+						t.Fatal(err)
+					}
+				})
+			})
+			if got := strings.Contains(out, "(that was"); got != (tt.warn != "") || !strings.Contains(out, tt.warn) {
+				t.Fatalf("output:\n%s\nwant warning %q", out, tt.warn)
+			}
+			log, err := LoadDayLog(DayFloor(time.Now()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(log.Answers["Q"]) != 1 {
+				t.Fatalf("long answer not stored: %+v", log.Answers)
+			}
+		})
+	}
+}
+
+func TestRunPromptsSkipPlaceholder(t *testing.T) {
+	for _, placeholder := range []string{"nothing", ""} {
+		useTempDirs(t)
+		cfg := Config{Questions: []string{"Q1", "Q2"}, SkipPlaceholder: placeholder}
+		withStdin(t, "done\n\n", func() {
+			captureStdout(t, func() {
+				if err := RunPrompts(cfg, false, false); err != nil {
+					t.Fatal(err)
+				}
+			})
+		})
+		log, err := LoadDayLog(DayFloor(time.Now()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := log.Answers["Q1"]; len(got) != 1 || got[0].Response != "done" {
+			t.Fatalf("placeholder %q: Q1 = %+v", placeholder, got)
+		}
+		skipped := log.Answers["Q2"]
+		if placeholder == "" {
+			if len(skipped) != 0 {
+				t.Fatalf("skip without a placeholder stored %+v", skipped)
+			}
+			continue
+		}
+		if len(skipped) != 1 || skipped[0].Response != placeholder {
+			t.Fatalf("skip with placeholder %q stored %+v", placeholder, skipped)
+		}
+	}
+}
+
+func TestShuffleQuestionsIsSeeded(t *testing.T) {
+	questions := []string{"A", "B", "C", "D", "E", "F"}
+	first := shuffleQuestions(questions, 42)
+	if again := shuffleQuestions(questions, 42); !reflect.DeepEqual(first, again) {
+		t.Fatalf("same seed gave %v then %v", first, again)
+	}
+	sorted := append([]string(nil), first...)
+	sort.Strings(sorted)
+	if !reflect.DeepEqual(sorted, questions) {
+		t.Fatalf("%v is not a permutation of %v", first, questions)
+	}
+	if !reflect.DeepEqual(questions, []string{"A", "B", "C", "D", "E", "F"}) {
+		t.Fatalf("input reordered: %v", questions)
+	}
+	changed := false
+	for seed := int64(0); seed < 10 && !changed; seed++ {
+		changed = !reflect.DeepEqual(shuffleQuestions(questions, seed), questions)
+	}
+	if !changed {
+		t.Fatal("no seed changed the order")
+	}
+}
+
+func TestRunPromptsShufflesPromptOrderOnly(t *testing.T) {
+	useTempDirs(t)
+	questions := []string{"Q1", "Q2", "Q3", "Q4"}
+	cfg := Config{Questions: questions, ShuffleQuestions: boolPtr(true)}
+	today := DayFloor(time.Now())
+	order := shuffleQuestions(questions, today.Unix())
+	var out string
+	withStdin(t, "r0\nr1\nr2\nr3\n", func() {
+		out = captureStdout(t, func() {
+			if err := RunPrompts(cfg, false, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	last := -1
+	for _, q := range order {
+		idx := strings.Index(out, q+"\n> ")
+		if idx <= last {
+			t.Fatalf("prompts not in shuffled order %v:\n%s", order, out)
+		}
+		last = idx
+	}
+	log, err := LoadDayLog(today)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, q := range order {
+		if got := log.Answers[q]; len(got) != 1 || got[0].Response != fmt.Sprintf("r%d", i) {
+			t.Fatalf("%s = %+v, want r%d", q, got, i)
+		}
+	}
+}
+
+func TestRunPromptsEchoesRecordedEntries(t *testing.T) {
+	useTempDirs(t)
+	today := DayFloor(time.Now())
+	writeDay(t, today, dayWith(today, "Q1", "logged earlier"))
+	cfg := Config{Questions: []string{"Q1", "Q2", "Q3"}}
+	var out string
+	withStdin(t, "new one\n\nthird\n", func() {
+		out = captureStdout(t, func() {
+			if err := RunPrompts(cfg, false, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	_, summary, ok := strings.Cut(out, "Entries saved.\n\n")
+	if !ok {
+		t.Fatalf("no summary after saving:\n%s", out)
+	}
+	if !strings.HasPrefix(summary, today.Format("2006-01-02")+"\n") {
+		t.Fatalf("summary does not start with the date:\n%s", summary)
+	}
+	q1, q3 := strings.Index(summary, "  Q1\n"), strings.Index(summary, "  Q3\n")
+	if q1 < 0 || q3 < q1 || !strings.Contains(summary, "] new one\n") || !strings.Contains(summary, "] third\n") {
+		t.Fatalf("summary missing the recorded entries:\n%s", summary)
+	}
+	if strings.Contains(summary, "logged earlier") || strings.Contains(summary, "Q2") {
+		t.Fatalf("summary lists more than this run recorded:\n%s", summary)
+	}
+}
+
+func TestWarnResponseLenZeroSurvivesReload(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}, WarnResponseLen: intPtr(0), EntrySoftLimitChars: intPtr(10)}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.WarnResponseLength(); got != 0 {
+		t.Fatalf("WarnResponseLength after reload = %d, want 0", got)
+	}
+}
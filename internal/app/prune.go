@@ -0,0 +1,94 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func RunPrune(args []string, dryRun bool) error {
+	empty, force := false, false
+	for _, arg := range args {
+		switch arg {
+		case "--empty":
+			empty = true
+		case "--force":
+			force = true
+		default:
+			return fmt.Errorf("unknown prune argument %q", arg)
+		}
+	}
+	if !empty {
+		return fmt.Errorf("nothing to prune, expected `wlog prune --empty [--force]`")
+	}
+
+	paths, err := emptyDayFiles()
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		fmt.Println("No empty day files found.")
+		return nil
+	}
+	if dryRun {
+		printPlannedRemove(paths)
+		return nil
+	}
+	if !force {
+		ok, err := confirm(fmt.Sprintf("Remove %d empty day %s?", len(paths), pluralize(len(paths), "file", "files")))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Nothing removed.")
+			return nil
+		}
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Pruned %d empty day %s.\n", len(paths), pluralize(len(paths), "file", "files"))
+	return nil
+}
+
+// emptyDayFiles returns the day files that hold no answers, mood or trash.
+// Files that fail to decode are left alone, since they may still hold
+// recoverable data.
+func emptyDayFiles() ([]string, error) {
+	paths, err := ListDayFiles()
+	if err != nil {
+		return nil, err
+	}
+	var empty []string
+	for _, path := range paths {
+		log, err := readDayLogFile(path)
+		if err != nil {
+			continue
+		}
+		if !dayLogHasEntries(log) && log.Mood == 0 && len(log.Trash) == 0 {
+			empty = append(empty, path)
+		}
+	}
+	return empty, nil
+}
+
+// confirm asks a yes/no question on stdin. It refuses to guess when stdin is
+// not a terminal, so scripts have to opt in with --force.
+func confirm(prompt string) (bool, error) {
+	if !stdinIsTerminal() {
+		return false, fmt.Errorf("%s pass --force to confirm when stdin is not a terminal", prompt)
+	}
+	fmt.Printf("%s [y/N] ", prompt)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunPruneEmpty(t *testing.T) {
+	dataDir := useTempDirs(t)
+	files := map[string]string{
+		"2026-03-01.json": `{"date":"2026-03-01","answers":{}}`,
+		"2026-03-02.json": `{"date":"2026-03-02","answers":{"Q":[]}}`,
+		"2026-03-03.json": `{"date":"2026-03-03","answers":{"Q":[{"time":"2026-03-03T09:00:00Z","response":"kept"}]}}`,
+		"2026-03-04.json": `{"date":"2026-03-04","mood":3,"answers":{}}`,
+		"2026-03-05.json": `{"date":"2026-03-05","answers":{},"trash":[{"question":"Q","deletedAt":"2026-03-05T10:00:00Z","answer":{"time":"2026-03-05T09:00:00Z","response":"gone"}}]}`,
+		"2026-03-06.json": `{not json`,
+	}
+	for name, data := range files {
+		writeFile(t, filepath.Join(dataDir, name), data)
+	}
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dataDir, name))
+		return err == nil
+	}
+
+	withStdin(t, "", func() {
+		err := RunPrune([]string{"--empty"}, false)
+		if err == nil || !strings.Contains(err.Error(), "--force") {
+			t.Fatalf("prune without a terminal or --force: %v", err)
+		}
+	})
+	if !exists("2026-03-01.json") {
+		t.Fatal("prune removed files without confirmation")
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunPrune([]string{"--empty", "--force"}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Pruned 2 empty day files.") {
+		t.Fatalf("output = %q", out)
+	}
+	for name := range files {
+		want := name != "2026-03-01.json" && name != "2026-03-02.json"
+		if exists(name) != want {
+			t.Errorf("%s exists = %v, want %v", name, !want, want)
+		}
+	}
+
+	out = captureStdout(t, func() {
+		if err := RunPrune([]string{"--empty", "--force"}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out != "No empty day files found.\n" {
+		t.Fatalf("second prune output = %q", out)
+	}
+	if err := RunPrune(nil, false); err == nil {
+		t.Fatal("prune without --empty did nothing silently")
+	}
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RunRemind checks today's log and exits with ExitNoEntries when something is
+// missing: any entry at all, or with --require, an entry for each of the
+// selected questions. It is meant for shell prompts and cron jobs.
+func RunRemind(args []string, cfg Config) error {
+	var selectors []string
+	for i := 0; i < len(args); i++ {
+		name, _, _ := strings.Cut(args[i], "=")
+		if name != "--require" {
+			return fmt.Errorf("unknown argument %q, expected `wlog remind [--require <question>]...`", args[i])
+		}
+		value, err := flagValue(args, &i)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, value)
+	}
+	required := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		q, err := resolveQuestion(selector, cfg.Questions)
+		if err != nil {
+			return err
+		}
+		required = append(required, q)
+	}
+
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		return err
+	}
+	if len(required) == 0 {
+		if !dayLogHasEntries(log) {
+			fmt.Println("Nothing logged today yet. Run `wlog` to answer your questions.")
+			return ErrNoEntries
+		}
+		return nil
+	}
+
+	missing := missingQuestions(log, required)
+	if len(missing) == 0 {
+		return nil
+	}
+	fmt.Printf("No entries today for %d required %s:\n", len(missing), pluralize(len(missing), "question", "questions"))
+	for _, q := range missing {
+		fmt.Printf("  - %s\n", q)
+	}
+	return ErrNoEntries
+}
+
+// missingQuestions returns the questions in required that have no answers in
+// log, keeping their order and dropping repeats.
+func missingQuestions(log DayLog, required []string) []string {
+	seen := make(map[string]bool, len(required))
+	var missing []string
+	for _, q := range required {
+		if seen[q] {
+			continue
+		}
+		seen[q] = true
+		if len(log.Answers[q]) == 0 {
+			missing = append(missing, q)
+		}
+	}
+	return missing
+}
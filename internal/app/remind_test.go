@@ -0,0 +1,40 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunRemindRequire(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Done?", "Blocked?", "Plan?"}}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runOutput(t, "remind")
+	if !errors.Is(err, ErrNoEntries) || out != "Nothing logged today yet. Run `wlog` to answer your questions.\n" {
+		t.Fatalf("remind on an empty day: %q, %v", out, err)
+	}
+
+	today := DayFloor(time.Now())
+	log := dayWith(today, "Done?", "shipped")
+	log.Answers["Plan?"] = []Answer{}
+	writeDay(t, today, log)
+
+	if out, err := runOutput(t, "remind", "--require", "1"); err != nil || out != "" {
+		t.Fatalf("all required answered: %q, %v", out, err)
+	}
+	out, err = runOutput(t, "remind", "--require", "Done?", "--require", "2", "--require=3", "--require", "blocked?")
+	if ExitCode(err) != ExitNoEntries {
+		t.Fatalf("missing questions: exit code %d (%v), want %d", ExitCode(err), err, ExitNoEntries)
+	}
+	want := "No entries today for 2 required questions:\n  - Blocked?\n  - Plan?\n"
+	if out != want {
+		t.Fatalf("output:\n%s\nwant:\n%s", out, want)
+	}
+	if _, err := runOutput(t, "remind", "--require"); err == nil {
+		t.Fatal("--require without a value accepted")
+	}
+}
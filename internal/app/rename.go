@@ -0,0 +1,115 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RunRenameQuestion moves the answers for one question text to another in
+// every day file, archived ones included, and moves the question's
+// questionStyles entry along with them.
+func RunRenameQuestion(args []string, cfg Config, dryRun bool) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: wlog rename-question <old> <new>")
+	}
+	oldQ := strings.TrimSpace(args[0])
+	newQ := strings.TrimSpace(args[1])
+	if oldQ == "" || newQ == "" {
+		return fmt.Errorf("question text cannot be empty")
+	}
+	if oldQ == newQ {
+		return fmt.Errorf("old and new question are the same")
+	}
+
+	paths, err := listDayFiles(true)
+	if err != nil {
+		return err
+	}
+	settings := cfg.Settings()
+	updated := 0
+	for _, path := range paths {
+		day, _ := dayFromFileName(filepath.Base(path))
+		log, err := readDayLogFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		moved := len(log.Answers[oldQ])
+		if !renameQuestion(&log, oldQ, newQ) {
+			continue
+		}
+		updated++
+		if dryRun {
+			fmt.Printf("Dry run: would move %d %s in %s\n", moved, pluralize(moved, "entry", "entries"), path)
+			continue
+		}
+		if err := writeDayLogFile(path, day, log, settings); err != nil {
+			return err
+		}
+	}
+
+	styled := renameQuestionStyle(&cfg, oldQ, newQ)
+	if dryRun {
+		if styled {
+			fmt.Printf("Dry run: would move the questionStyles entry for %q to %q\n", oldQ, newQ)
+		}
+		return nil
+	}
+	fmt.Printf("Renamed question in %d day %s.\n", updated, pluralize(updated, "file", "files"))
+	if styled {
+		if err := SaveConfig(cfg); err != nil {
+			return err
+		}
+		fmt.Printf("Moved the questionStyles entry for %q to %q.\n", oldQ, newQ)
+	}
+	return nil
+}
+
+// renameQuestionStyle moves the style keyed by oldQ to newQ, keeping a style
+// newQ already has. It reports whether cfg changed.
+func renameQuestionStyle(cfg *Config, oldQ, newQ string) bool {
+	style, ok := cfg.QuestionStyles[oldQ]
+	if !ok {
+		return false
+	}
+	styles := make(map[string]QuestionStyle, len(cfg.QuestionStyles))
+	for q, s := range cfg.QuestionStyles {
+		styles[q] = s
+	}
+	delete(styles, oldQ)
+	if _, exists := styles[newQ]; !exists {
+		styles[newQ] = style
+	}
+	cfg.QuestionStyles = styles
+	return true
+}
+
+// renameQuestion moves the answers keyed by oldQ to newQ, merging with any
+// answers already under newQ in timestamp order. It reports whether the log
+// changed.
+func renameQuestion(log *DayLog, oldQ, newQ string) bool {
+	moved, ok := log.Answers[oldQ]
+	if !ok {
+		return false
+	}
+	delete(log.Answers, oldQ)
+	if len(moved) == 0 {
+		return true
+	}
+	merged := append(append([]Answer(nil), log.Answers[newQ]...), moved...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return answerTime(merged[i]).Before(answerTime(merged[j]))
+	})
+	log.Answers[newQ] = merged
+	return true
+}
+
+func answerTime(ans Answer) time.Time {
+	t, err := time.Parse(time.RFC3339, ans.Time)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenameQuestionMovesStyle(t *testing.T) {
+	tests := []struct {
+		name   string
+		styles map[string]QuestionStyle
+		want   map[string]QuestionStyle
+	}{
+		{
+			name:   "moves to the new text",
+			styles: map[string]QuestionStyle{"Old?": {Color: "205", Icon: "★"}, "Other?": {Icon: "•"}},
+			want:   map[string]QuestionStyle{"New?": {Color: "205", Icon: "★"}, "Other?": {Icon: "•"}},
+		},
+		{
+			name:   "keeps an existing style for the new text",
+			styles: map[string]QuestionStyle{"Old?": {Icon: "★"}, "New?": {Icon: "✓"}},
+			want:   map[string]QuestionStyle{"New?": {Icon: "✓"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			if err := SaveConfig(Config{Questions: []string{"New?"}, QuestionStyles: tt.styles}); err != nil {
+				t.Fatal(err)
+			}
+			writeDay(t, mustDay(t, "2026-03-02"), DayLog{Answers: map[string][]Answer{"Old?": {{Time: "2026-03-02T09:00:00Z", Response: "x"}}}})
+			out := captureStdout(t, func() {
+				if err := Run([]string{"rename-question", "Old?", "New?"}, BuildInfo{}); err != nil {
+					t.Fatal(err)
+				}
+			})
+			if !strings.Contains(out, `Moved the questionStyles entry for "Old?" to "New?".`) {
+				t.Fatalf("unexpected output: %q", out)
+			}
+			cfg, err := LoadConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cfg.QuestionStyles, tt.want) {
+				t.Fatalf("questionStyles = %v, want %v", cfg.QuestionStyles, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenameQuestionStyleDryRun(t *testing.T) {
+	useTempDirs(t)
+	styles := map[string]QuestionStyle{"Old?": {Icon: "★"}}
+	if err := SaveConfig(Config{Questions: []string{"Old?"}, QuestionStyles: styles}); err != nil {
+		t.Fatal(err)
+	}
+	out := captureStdout(t, func() {
+		if err := Run([]string{"--dry-run", "rename-question", "Old?", "New?"}, BuildInfo{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "would move the questionStyles entry") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg.QuestionStyles, styles) {
+		t.Fatalf("dry run changed questionStyles to %v", cfg.QuestionStyles)
+	}
+}
+
+func TestRenameQuestionRewritesDayFiles(t *testing.T) {
+	useTempDirs(t)
+	first, second, untouched := mustDay(t, "2026-03-02"), mustDay(t, "2026-03-03"), mustDay(t, "2026-03-04")
+	at := func(day time.Time, h int) string { return day.Add(time.Duration(h) * time.Hour).Format(time.RFC3339) }
+	writeDay(t, first, DayLog{Answers: map[string][]Answer{
+		"Old?": {{Time: at(first, 9), Response: "a"}, {Time: at(first, 11), Response: "b"}},
+	}})
+	writeDay(t, second, DayLog{Answers: map[string][]Answer{
+		"Old?": {{Time: at(second, 10), Response: "old"}},
+		"New?": {{Time: at(second, 9), Response: "early"}, {Time: at(second, 12), Response: "late"}},
+	}})
+	writeDay(t, untouched, dayWith(untouched, "Other?", "x"))
+	before := readDayFile(t, untouched)
+
+	out := captureStdout(t, func() {
+		if err := RunRenameQuestion([]string{"Old?", "New?"}, Config{}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Renamed question in 2 day files.") {
+		t.Fatalf("output = %q", out)
+	}
+
+	tests := []struct {
+		day  time.Time
+		want []Answer
+	}{
+		{first, []Answer{{Time: at(first, 9), Response: "a"}, {Time: at(first, 11), Response: "b"}}},
+		{second, []Answer{{Time: at(second, 9), Response: "early"}, {Time: at(second, 10), Response: "old"}, {Time: at(second, 12), Response: "late"}}},
+	}
+	for _, tt := range tests {
+		log, err := LoadDayLog(tt.day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := log.Answers["Old?"]; ok {
+			t.Errorf("%s still has the old question", log.Date)
+		}
+		if !reflect.DeepEqual(log.Answers["New?"], tt.want) {
+			t.Errorf("%s New? = %+v, want %+v", log.Date, log.Answers["New?"], tt.want)
+		}
+	}
+	if readDayFile(t, untouched) != before {
+		t.Fatal("a day without the old question was rewritten")
+	}
+}
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type restoreFile struct {
+	Name   string
+	Target string
+	Data   []byte
+}
+
+func RunRestore(args []string, dryRun bool) error {
+	force := false
+	var positional []string
+	for _, arg := range args {
+		switch arg {
+		case "--force":
+			force = true
+		default:
+			if strings.HasPrefix(arg, "--") {
+				return fmt.Errorf("unknown flag %q", arg)
+			}
+			positional = append(positional, arg)
+		}
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("expected `wlog restore [--force] <zip>`")
+	}
+
+	dir, err := DataDir()
+	if err != nil {
+		return err
+	}
+	configPath, err := ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	files, err := readBackup(positional[0], dir, configPath)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("%s contains no wlog files", positional[0])
+	}
+
+	if !force {
+		var existing []string
+		for _, f := range files {
+			if _, err := os.Stat(f.Target); err == nil {
+				existing = append(existing, f.Target)
+			}
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("refusing to overwrite %d existing %s (first: %s), pass --force to replace them",
+				len(existing), pluralize(len(existing), "file", "files"), existing[0])
+		}
+	}
+
+	for _, f := range files {
+		if dryRun {
+			fmt.Printf("Dry run: would write %s\n", f.Target)
+			continue
+		}
+		if err := EnsureDir(filepath.Dir(f.Target)); err != nil {
+			return err
+		}
+		if err := os.WriteFile(f.Target, f.Data, 0o644); err != nil {
+			return err
+		}
+	}
+	if !dryRun {
+		fmt.Printf("Restored %d %s from %s\n", len(files), pluralize(len(files), "file", "files"), positional[0])
+	}
+	return nil
+}
+
+// readBackup loads every entry of a backup archive and resolves where it
+// belongs. The whole archive is validated before anything is written: only
+// config/config.{json,yaml,yml}, data/<YYYY-MM-DD>.json and
+// data/archive/<YYYY-MM-DD>.json entries are restored, and each must decode
+// as a config or day log respectively. Other entries, such as the state file
+// kept by older backups, are skipped with a warning.
+func readBackup(src, dir, configPath string) ([]restoreFile, error) {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	var files []restoreFile
+	for _, entry := range zr.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		data, err := readZipEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		f := restoreFile{Name: entry.Name, Data: data}
+		switch {
+		case strings.HasPrefix(entry.Name, backupConfigPrefix):
+			name := strings.TrimPrefix(entry.Name, backupConfigPrefix)
+			if name != "config.json" && name != "config.yaml" && name != "config.yml" {
+				Warnf("skipping %s, not a wlog config file\n", entry.Name)
+				continue
+			}
+			if isYAMLConfig(name) != isYAMLConfig(configPath) {
+				return nil, fmt.Errorf("%s does not match the format of %s, pass --config with a matching extension", entry.Name, configPath)
+			}
+			raw, err := decodeConfigData(name, data)
+			if err == nil {
+				_, err = configFromMap(raw)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid config file: %w", entry.Name, err)
+			}
+			f.Target = configPath
+		case strings.HasPrefix(entry.Name, backupDataPrefix):
+			name := strings.TrimPrefix(entry.Name, backupDataPrefix)
+			if !isBackupDayFile(name) {
+				Warnf("skipping %s, not a wlog day file\n", entry.Name)
+				continue
+			}
+			var log DayLog
+			if err := json.Unmarshal(data, &log); err != nil {
+				return nil, fmt.Errorf("%s is not a valid day file: %w", entry.Name, err)
+			}
+			f.Target = filepath.Join(dir, filepath.FromSlash(name))
+		default:
+			Warnf("skipping %s, not part of a wlog backup\n", entry.Name)
+			continue
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+func readZipEntry(entry *zip.File) ([]byte, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// RunRm removes the day files for a date or an interval such as
+// "last 3 days". It always asks first unless --force is given.
+func RunRm(args []string, dryRun bool) error {
+	force := false
+	var words []string
+	for _, arg := range args {
+		switch {
+		case arg == "--force":
+			force = true
+		case strings.HasPrefix(arg, "--"):
+			return fmt.Errorf("unknown rm argument %q", arg)
+		default:
+			words = append(words, arg)
+		}
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("usage: wlog rm [--force] <date|interval>")
+	}
+	start, end, err := rmRange(strings.Join(words, " "))
+	if err != nil {
+		return err
+	}
+
+	paths, err := existingDayFiles(start, end)
+	if err != nil {
+		return err
+	}
+	label := start.Format("2006-01-02")
+	if !start.Equal(end) {
+		label += " to " + end.Format("2006-01-02")
+	}
+	if len(paths) == 0 {
+		fmt.Printf("No day files found for %s.\n", label)
+		return nil
+	}
+	if dryRun {
+		printPlannedRemove(paths)
+		return nil
+	}
+	if !force {
+		ok, err := confirm(fmt.Sprintf("Remove %d day %s for %s?", len(paths), pluralize(len(paths), "file", "files"), label))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Nothing removed.")
+			return nil
+		}
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Removed %d day %s for %s.\n", len(paths), pluralize(len(paths), "file", "files"), label)
+	return nil
+}
+
+// rmRange accepts a single day as parseAddDate does, or anything
+// ParseInterval understands.
+func rmRange(value string) (time.Time, time.Time, error) {
+	if day, err := parseAddDate(value); err == nil {
+		return day, day, nil
+	}
+	return ParseInterval(value)
+}
+
+// existingDayFiles returns the paths of the day files between start and end
+// inclusive that exist on disk, archived ones included.
+func existingDayFiles(start, end time.Time) ([]string, error) {
+	var paths []string
+	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
+		path, err := findDayFile(cursor, true)
+		if err != nil {
+			return nil, err
+		}
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRmRange(t *testing.T) {
+	today := DayFloor(time.Now())
+	day := mustDay(t, "2026-03-02")
+	tests := []struct {
+		value      string
+		start, end time.Time
+	}{
+		{"2026-03-02", day, day},
+		{" 2026-03-02 ", day, day},
+		{"today", today, today},
+		{"yesterday", today.AddDate(0, 0, -1), today.AddDate(0, 0, -1)},
+		{"last 3 days", today.AddDate(0, 0, -2), today},
+	}
+	for _, tt := range tests {
+		start, end, err := rmRange(tt.value)
+		if err != nil || !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Errorf("rmRange(%q) = %s, %s, %v; want %s, %s", tt.value, start, end, err, tt.start, tt.end)
+		}
+	}
+	if _, _, err := rmRange("someday"); err == nil {
+		t.Error("rmRange accepted an unknown interval")
+	}
+}
+
+func TestRunRmRemovesRange(t *testing.T) {
+	useTempDirs(t)
+	today := DayFloor(time.Now())
+	days := []time.Time{today, today.AddDate(0, 0, -1), today.AddDate(0, 0, -2), today.AddDate(0, 0, -5)}
+	for _, day := range days {
+		writeDay(t, day, DayLog{Answers: map[string][]Answer{"Q": {{Time: day.Add(9 * time.Hour).Format(time.RFC3339), Response: "x"}}}})
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunRm([]string{"--force", "last", "3", "days"}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Removed 3 day files") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	for i, day := range days {
+		path, err := findDayFile(day, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kept := path != ""; kept != (i == 3) {
+			t.Errorf("day %s kept = %v", day.Format("2006-01-02"), kept)
+		}
+	}
+}
+
+func TestRunRmConfirmation(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-02T09:00:00Z", Response: "x"}}}})
+	path, err := DayFilePath(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withStdin(t, "y\n", func() {
+		err := RunRm([]string{"2026-03-02"}, false)
+		if err == nil || !strings.Contains(err.Error(), "pass --force") {
+			t.Fatalf("error = %v, want a hint about --force", err)
+		}
+	})
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("unconfirmed rm removed the day file: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := RunRm([]string{"2026-03-02"}, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, path) {
+		t.Fatalf("dry run did not list %s:\n%s", path, out)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("dry run removed the day file: %v", err)
+	}
+
+	captureStdout(t, func() {
+		withStdin(t, "", func() {
+			if err := RunRm([]string{"--force", "2026-03-02"}, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	if _, err := os.Stat(path); err == nil {
+		t.Fatal("rm --force left the day file")
+	}
+}
+
+func TestRunRmMissingDaysLeavesDataDirAlone(t *testing.T) {
+	dataDir := useTempDirs(t)
+	out := captureStdout(t, func() {
+		if err := RunRm([]string{"2026-03-02"}, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "No day files found for 2026-03-02.") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if _, err := os.Stat(dataDir); err == nil {
+		t.Fatal("rm created the data directory")
+	}
+	if err := RunRm([]string{"--all"}, false); err == nil {
+		t.Fatal("expected an error for an unknown flag")
+	}
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// RunSchema prints the JSON Schema for day files or the config file.
+func RunSchema(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: wlog schema day|config")
+	}
+	var schema map[string]any
+	switch args[0] {
+	case "day":
+		schema = DayLogSchema()
+	case "config":
+		schema = ConfigSchema()
+	default:
+		return fmt.Errorf("unknown schema %q, expected day or config", args[0])
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// DayLogSchema describes a day file. It is generated from the DayLog struct
+// tags, with answers accepting both the object form and the list form
+// written when orderedAnswers is on.
+func DayLogSchema() map[string]any {
+	schema := schemaFor(reflect.TypeOf(DayLog{}))
+	props := schema["properties"].(map[string]any)
+	props["date"] = map[string]any{"type": "string", "pattern": `^\d{4}-\d{2}-\d{2}$`}
+	props["mood"] = map[string]any{"type": "integer", "minimum": MinMood, "maximum": MaxMood}
+	ordered := schemaFor(reflect.TypeOf(OrderedDayLog{}))["properties"].(map[string]any)["answers"]
+	props["answers"] = map[string]any{
+		"oneOf": []any{props["answers"], ordered, map[string]any{"type": "null"}},
+	}
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "wlog day file"
+	return schema
+}
+
+// ConfigSchema describes the config file, generated from the Config struct
+// tags. Keys starting with "_" are the default markers LoadConfig writes.
+func ConfigSchema() map[string]any {
+	schema := schemaFor(reflect.TypeOf(Config{}))
+	schema["patternProperties"] = map[string]any{"^_": map[string]any{}}
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "wlog config file"
+	return schema
+}
+
+// schemaFor maps a Go type to a JSON Schema following encoding/json: fields
+// without omitempty are required, pointers are their element type, and the
+// fields of embedded structs are promoted.
+func schemaFor(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaFor(t.Elem())}
+	case reflect.Struct:
+		props := make(map[string]any)
+		var required []string
+		addStructFields(t, props, &required)
+		schema := map[string]any{"type": "object", "properties": props}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	}
+	return map[string]any{}
+}
+
+func addStructFields(t reflect.Type, props map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addStructFields(field.Type, props, required)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		props[name] = schemaFor(field.Type)
+		if !strings.Contains(","+opts+",", ",omitempty,") {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+func TestDayLogSchemaValidatesDayFiles(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	log := DayLog{
+		Mood: 4,
+		Answers: map[string][]Answer{
+			"What did you do?": {{Time: "2026-03-02T09:00:00Z", Response: "standup", Pinned: true}},
+			"Blockers?":        {{Time: "2026-03-02T10:00:00Z", Response: "review", Priority: "high"}},
+			"Empty?":           {},
+		},
+		Trash: []TrashedAnswer{{Question: "Blockers?", DeletedAt: "2026-03-02T12:00:00Z", Answer: Answer{Time: "2026-03-02T11:00:00Z", Response: "gone"}}},
+	}
+	tests := []struct {
+		name     string
+		settings Settings
+	}{
+		{"object form", Settings{}},
+		{"list form", Config{Questions: []string{"Blockers?", "What did you do?"}, OrderedAnswers: boolPtr(true)}.Settings()},
+		{"compact", Config{CompactStorage: boolPtr(true)}.Settings()},
+	}
+	schema := roundTripSchema(t, DayLogSchema())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SaveDayLog(day, log, tt.settings); err != nil {
+				t.Fatal(err)
+			}
+			data := readDayFile(t, day)
+			if err := validateSchema(schema, decodeJSON(t, data), "$"); err != nil {
+				t.Fatalf("%v\n%s", err, data)
+			}
+		})
+	}
+}
+
+func TestDayLogSchemaRejectsInvalidDayFiles(t *testing.T) {
+	schema := roundTripSchema(t, DayLogSchema())
+	valid := `{"date":"2026-03-02","answers":{"Q":[{"time":"2026-03-02T09:00:00Z","response":"a"}]}}`
+	if err := validateSchema(schema, decodeJSON(t, valid), "$"); err != nil {
+		t.Fatalf("valid file rejected: %v", err)
+	}
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing date", `{"answers":{}}`},
+		{"bad date", `{"date":"March 2","answers":{}}`},
+		{"mood out of range", `{"date":"2026-03-02","answers":{},"mood":9}`},
+		{"fractional mood", `{"date":"2026-03-02","answers":{},"mood":2.5}`},
+		{"numeric response", `{"date":"2026-03-02","answers":{"Q":[{"time":"t","response":3}]}}`},
+		{"answer without time", `{"date":"2026-03-02","answers":{"Q":[{"response":"a"}]}}`},
+		{"list item without question", `{"date":"2026-03-02","answers":[{"answers":[]}]}`},
+		{"string answers", `{"date":"2026-03-02","answers":"a"}`},
+		{"trash without deletedAt", `{"date":"2026-03-02","answers":{},"trash":[{"question":"Q","time":"t","response":"a"}]}`},
+	}
+	for _, tt := range tests {
+		if err := validateSchema(schema, decodeJSON(t, tt.data), "$"); err == nil {
+			t.Errorf("%s: accepted %s", tt.name, tt.data)
+		}
+	}
+}
+
+func TestConfigSchemaValidatesSavedConfig(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}, OrderedAnswers: boolPtr(true), Locale: "de"}); err != nil {
+		t.Fatal(err)
+	}
+	path, err := ConfigFilePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := readConfigMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validateSchema(roundTripSchema(t, ConfigSchema()), any(raw), "$"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// roundTripSchema passes schema through JSON, as a validator reading the
+// output of `wlog schema` would see it.
+func roundTripSchema(t *testing.T, schema map[string]any) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decodeJSON(t, string(data)).(map[string]any)
+}
+
+func decodeJSON(t *testing.T, data string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// validateSchema checks value against the subset of JSON Schema draft-07 that
+// schemaFor and DayLogSchema produce.
+func validateSchema(schema map[string]any, value any, at string) error {
+	if options, ok := schema["oneOf"].([]any); ok {
+		matched := 0
+		for _, option := range options {
+			if validateSchema(option.(map[string]any), value, at) == nil {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return fmt.Errorf("%s: matches %d of the oneOf schemas, want exactly 1", at, matched)
+		}
+	}
+	if typ, ok := schema["type"].(string); ok && !schemaTypeMatches(typ, value) {
+		return fmt.Errorf("%s: %#v is not of type %s", at, value, typ)
+	}
+	switch v := value.(type) {
+	case string:
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(v) {
+			return fmt.Errorf("%s: %q does not match %s", at, v, pattern)
+		}
+	case float64:
+		if min, ok := schema["minimum"].(float64); ok && v < min {
+			return fmt.Errorf("%s: %v is below %v", at, v, min)
+		}
+		if max, ok := schema["maximum"].(float64); ok && v > max {
+			return fmt.Errorf("%s: %v is above %v", at, v, max)
+		}
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range v {
+				if err := validateSchema(items, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		if required, ok := schema["required"].([]any); ok {
+			for _, name := range required {
+				if _, ok := v[name.(string)]; !ok {
+					return fmt.Errorf("%s: missing required %q", at, name)
+				}
+			}
+		}
+		props, _ := schema["properties"].(map[string]any)
+		patterns, _ := schema["patternProperties"].(map[string]any)
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			path := at + "." + key
+			if prop, ok := props[key].(map[string]any); ok {
+				if err := validateSchema(prop, v[key], path); err != nil {
+					return err
+				}
+				continue
+			}
+			matchedPattern := false
+			for pattern, sub := range patterns {
+				if regexp.MustCompile(pattern).MatchString(key) {
+					matchedPattern = true
+					if err := validateSchema(sub.(map[string]any), v[key], path); err != nil {
+						return err
+					}
+				}
+			}
+			if matchedPattern {
+				continue
+			}
+			if extra, ok := schema["additionalProperties"].(map[string]any); ok {
+				if err := validateSchema(extra, v[key], path); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func schemaTypeMatches(typ string, value any) bool {
+	switch typ {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RunSet appends one entry per `--set key=value` flag to today's log without
+// prompting. Keys are resolved like `wlog add` selectors, and every key must
+// resolve before anything is written.
+func RunSet(args []string, cfg Config, dryRun bool) error {
+	type setEntry struct{ question, response string }
+	var entries []setEntry
+	for i := 0; i < len(args); i++ {
+		name, _, _ := strings.Cut(args[i], "=")
+		if name != "--set" {
+			return fmt.Errorf("unexpected argument %q, expected --set key=value", args[i])
+		}
+		value, err := flagValue(args, &i)
+		if err != nil {
+			return err
+		}
+		key, response, ok := strings.Cut(value, "=")
+		if !ok {
+			return fmt.Errorf("invalid --set %q, expected key=value", value)
+		}
+		question, err := resolveQuestion(key, cfg.Questions)
+		if err != nil {
+			return fmt.Errorf("--set %q: %w", key, err)
+		}
+		if response = strings.TrimSpace(response); response == "" {
+			continue
+		}
+		entries = append(entries, setEntry{question, response})
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("nothing to add, pass --set key=value with a non-empty value")
+	}
+
+	today := DayFloor(time.Now())
+	log, err := LoadDayLog(today)
+	if err != nil {
+		return err
+	}
+	settings := cfg.Settings()
+	timestamp := settings.EntryTimestamp(time.Now())
+	var added []plannedAnswer
+	for _, entry := range entries {
+		if cfg.DedupeEntriesEnabled() && HasResponse(log.Answers[entry.question], entry.response) {
+			fmt.Printf("Duplicate skipped: %s\n", entry.response)
+			continue
+		}
+		ans := Answer{Time: timestamp, Response: entry.response}
+		log.Answers[entry.question] = append(log.Answers[entry.question], ans)
+		added = append(added, plannedAnswer{Question: entry.question, Answer: ans})
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	if dryRun {
+		path, err := DayFilePath(today)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, added, settings)
+		return nil
+	}
+	if err := SaveDayLog(today, log, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Saved %d %s to %s.\n", len(added), pluralize(len(added), "entry", "entries"), today.Format("2006-01-02"))
+	return nil
+}
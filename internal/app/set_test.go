@@ -0,0 +1,69 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunSetMultipleFlags(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"What did you do yesterday?", "What will you do today?", "Blockers?"}}); err != nil {
+		t.Fatal(err)
+	}
+	out := captureStdout(t, func() {
+		err := Run([]string{"--set", "yesterday=did X", "--set=today=do Y", "--set", "3= ", "--set", "1=more = X"}, BuildInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(out, "Saved 3 entries") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var yesterday []string
+	for _, ans := range log.Answers["What did you do yesterday?"] {
+		yesterday = append(yesterday, ans.Response)
+	}
+	if strings.Join(yesterday, "|") != "did X|more = X" {
+		t.Errorf("yesterday answers = %q", yesterday)
+	}
+	if today := log.Answers["What will you do today?"]; len(today) != 1 || today[0].Response != "do Y" {
+		t.Errorf("today answers = %+v", today)
+	}
+	if len(log.Answers["Blockers?"]) != 0 {
+		t.Errorf("empty value was recorded: %+v", log.Answers["Blockers?"])
+	}
+}
+
+func TestRunSetRejectsBadKeys(t *testing.T) {
+	cfg := Config{Questions: []string{"What did you do yesterday?", "What will you do today?"}}
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"unknown key", []string{"--set", "today=ok", "--set", "lunch=pizza"}, `--set "lunch": no question matches "lunch"`},
+		{"ambiguous key", []string{"--set", "What=x"}, "matches 2 questions"},
+		{"index out of range", []string{"--set", "5=x"}, "out of range (1-2)"},
+		{"missing equals", []string{"--set", "today"}, `invalid --set "today", expected key=value`},
+		{"missing value", []string{"--set"}, "missing value for --set"},
+		{"other argument", []string{"--set", "today=x", "extra"}, `unexpected argument "extra"`},
+		{"only empty values", []string{"--set", "today="}, "nothing to add"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataDir := useTempDirs(t)
+			err := RunSet(tt.args, cfg, false)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if paths, _ := dayFilesIn(dataDir); len(paths) != 0 {
+				t.Fatalf("a rejected --set wrote %v", paths)
+			}
+		})
+	}
+}
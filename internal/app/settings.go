@@ -0,0 +1,106 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+// Settings are the config values that decide how day files are written and
+// how entry times and day labels are shown. Commands build them once with
+// Config.Settings and hand them to whatever needs them. The zero value writes
+// indented day files with sorted questions and shows local time in English.
+type Settings struct {
+	compactStorage     bool
+	orderedAnswers     bool
+	questionOrder      []string
+	timestampPrecision string
+	location           *time.Location
+	locale             *localeStrings
+}
+
+// Settings resolves the storage and display settings of cfg. An unknown
+// displayTimezone or locale falls back to local time or English with a
+// warning.
+func (cfg Config) Settings() Settings {
+	s := Settings{
+		compactStorage:     cfg.CompactStorageEnabled(),
+		orderedAnswers:     cfg.OrderedAnswersEnabled(),
+		questionOrder:      append([]string(nil), cfg.Questions...),
+		timestampPrecision: cfg.TimestampResolution(),
+	}
+	loc, err := resolveDisplayLocation(cfg.DisplayZone())
+	if err != nil {
+		Warnf("using local time for display: %v\n", err)
+		loc = time.Local
+	}
+	s.location = loc
+	labels, err := resolveLocale(cfg.LocaleName())
+	if err != nil {
+		Warnf("using English day labels: %v\n", err)
+	}
+	s.locale = &labels
+	return s
+}
+
+// EntryTimestamp formats t for Answer.Time, dropping the seconds when the
+// config asks for minute precision.
+func (s Settings) EntryTimestamp(t time.Time) string {
+	if s.timestampPrecision == TimestampPrecisionMinute {
+		t = t.Truncate(time.Minute)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// DisplayTime renders a stored RFC3339 timestamp as HH:MM in the display
+// zone. Values that do not parse are returned unchanged.
+func (s Settings) DisplayTime(value string) string {
+	if value == "" {
+		return ""
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return s.clock(t).Format("15:04")
+	}
+	return value
+}
+
+// clock converts a stored timestamp into the display zone.
+func (s Settings) clock(t time.Time) time.Time {
+	if s.location == nil {
+		return t.In(time.Local)
+	}
+	return t.In(s.location)
+}
+
+func (s Settings) labels() localeStrings {
+	if s.locale == nil {
+		return locales[defaultLocale]
+	}
+	return *s.locale
+}
+
+// FormatDayLabel renders day as "Mon 2006-01-02" with the weekday in the
+// configured locale.
+func (s Settings) FormatDayLabel(day time.Time) string {
+	return s.labels().weekdays[day.Weekday()] + " " + day.Format("2006-01-02")
+}
+
+// now is the clock RelativeDayLabel measures from; tests pin it.
+var now = time.Now
+
+func (s Settings) RelativeDayLabel(day time.Time) string {
+	labels := s.labels()
+	today := DayFloor(now())
+	switch {
+	case day.Equal(today):
+		return labels.today
+	case day.Equal(today.AddDate(0, 0, -1)):
+		return labels.yesterday
+	case day.Equal(today.AddDate(0, 0, 1)):
+		return labels.tomorrow
+	}
+	delta := DaysBetween(today, day)
+	if delta > 0 {
+		return fmt.Sprintf(labels.inDays, delta)
+	}
+	return fmt.Sprintf(labels.daysAgo, -delta)
+}
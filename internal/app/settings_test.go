@@ -0,0 +1,190 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSaveDayLogUsesGivenSettings(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	log := DayLog{Answers: map[string][]Answer{"Q": {{Time: "2026-03-02T09:00:00Z", Response: "a"}}}}
+
+	compact := Config{CompactStorage: boolPtr(true)}
+	if err := SaveDayLog(day, log, compact.Settings()); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDayFile(t, day); strings.Contains(got, "\n") {
+		t.Fatalf("compact settings wrote indented JSON:\n%s", got)
+	}
+
+	// Loading a different config must not change how the next save is written.
+	if _, err := LoadConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveDayLog(day, log, compact.Settings()); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDayFile(t, day); strings.Contains(got, "\n") {
+		t.Fatalf("save after LoadConfig ignored the given settings:\n%s", got)
+	}
+
+	if err := SaveDayLog(day, log, Settings{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDayFile(t, day); !strings.Contains(got, "\n  ") {
+		t.Fatalf("zero settings wrote compact JSON:\n%s", got)
+	}
+}
+
+func TestSettingsDisplayTime(t *testing.T) {
+	tokyo := Config{DisplayTimezone: "Asia/Tokyo"}.Settings()
+	utc := Config{DisplayTimezone: "UTC"}.Settings()
+	const stamp = "2026-03-02T09:30:00Z"
+	if got := tokyo.DisplayTime(stamp); got != "18:30" {
+		t.Errorf("Tokyo DisplayTime = %q, want 18:30", got)
+	}
+	if got := utc.DisplayTime(stamp); got != "09:30" {
+		t.Errorf("UTC DisplayTime = %q, want 09:30", got)
+	}
+	if got := utc.DisplayTime("not a time"); got != "not a time" {
+		t.Errorf("DisplayTime kept invalid value as %q", got)
+	}
+}
+
+func TestDisplayTimeConvertsStoredOffset(t *testing.T) {
+	// Written at 14:30 in a +05:00 zone, then read elsewhere.
+	const stamp = "2026-03-02T14:30:00+05:00"
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{"utc", "09:30"},
+		{"UTC", "09:30"},
+		{"America/New_York", "04:30"},
+		{"Asia/Kolkata", "15:00"},
+	}
+	for _, tt := range tests {
+		if got := (Config{DisplayTimezone: tt.zone}).Settings().DisplayTime(stamp); got != tt.want {
+			t.Errorf("DisplayTime in %s = %q, want %s", tt.zone, got, tt.want)
+		}
+	}
+
+	var settings Settings
+	warnings := captureStderr(t, func() {
+		settings = Config{DisplayTimezone: "Mars/Olympus"}.Settings()
+	})
+	if !strings.Contains(warnings, "Mars/Olympus") {
+		t.Errorf("no warning for an unknown zone: %q", warnings)
+	}
+	if got, want := settings.DisplayTime(stamp), time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC).In(time.Local).Format("15:04"); got != want {
+		t.Errorf("unknown zone DisplayTime = %q, want local %q", got, want)
+	}
+}
+
+func TestSettingsEntryTimestamp(t *testing.T) {
+	at := time.Date(2026, 3, 2, 9, 30, 45, 0, time.UTC)
+	minute := Config{TimestampPrecision: TimestampPrecisionMinute}.Settings()
+	if got := minute.EntryTimestamp(at); got != "2026-03-02T09:30:00Z" {
+		t.Errorf("minute precision = %q", got)
+	}
+	if got := (Settings{}).EntryTimestamp(at); got != "2026-03-02T09:30:45Z" {
+		t.Errorf("second precision = %q", got)
+	}
+}
+
+func TestSettingsFormatDayLabelLocale(t *testing.T) {
+	day := mustDay(t, "2026-03-02")
+	if got := (Settings{}).FormatDayLabel(day); got != "Mon 2026-03-02" {
+		t.Errorf("default label = %q", got)
+	}
+	de := Config{Locale: "de"}.Settings()
+	if got := de.FormatDayLabel(day); got == "Mon 2026-03-02" || !strings.HasSuffix(got, "2026-03-02") {
+		t.Errorf("de label = %q, want a German weekday", got)
+	}
+}
+
+func TestDaysBetweenAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	tests := []struct {
+		from, to time.Time
+		want     int
+	}{
+		// Clocks go forward on 2026-03-08, so the span is 47 hours.
+		{time.Date(2026, 3, 7, 0, 0, 0, 0, ny), time.Date(2026, 3, 9, 0, 0, 0, 0, ny), 2},
+		{time.Date(2026, 3, 9, 0, 0, 0, 0, ny), time.Date(2026, 3, 7, 0, 0, 0, 0, ny), -2},
+		// Clocks go back on 2026-11-01, so the span is 25 hours.
+		{time.Date(2026, 10, 31, 23, 30, 0, 0, ny), time.Date(2026, 11, 1, 0, 15, 0, 0, ny), 1},
+		{time.Date(2026, 1, 1, 0, 0, 0, 0, ny), time.Date(2026, 12, 31, 0, 0, 0, 0, ny), 364},
+		{time.Date(2026, 3, 2, 8, 0, 0, 0, ny), time.Date(2026, 3, 2, 23, 0, 0, 0, ny), 0},
+	}
+	for _, tt := range tests {
+		if got := DaysBetween(tt.from, tt.to); got != tt.want {
+			t.Errorf("DaysBetween(%s, %s) = %d, want %d", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestRelativeDayLabelAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	local := time.Local
+	time.Local = ny
+	t.Cleanup(func() { time.Local = local })
+
+	// A year either side of today crosses at least one DST change.
+	today := DayFloor(time.Now())
+	for n := 2; n <= 366; n++ {
+		if got, want := (Settings{}).RelativeDayLabel(today.AddDate(0, 0, -n)), fmt.Sprintf("%d days ago", n); got != want {
+			t.Fatalf("RelativeDayLabel(-%d) = %q, want %q", n, got, want)
+		}
+		if got, want := (Settings{}).RelativeDayLabel(today.AddDate(0, 0, n)), fmt.Sprintf("In %d days", n); got != want {
+			t.Fatalf("RelativeDayLabel(+%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestMinutePrecisionInSavedEntries(t *testing.T) {
+	useTempDirs(t)
+	cfg := Config{Questions: []string{"Q1", "Q2"}, TimestampPrecision: TimestampPrecisionMinute}
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runOutput(t, "add", "1", "from add"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runOutput(t, "--set", "2=from set"); err != nil {
+		t.Fatal(err)
+	}
+	withStdin(t, "from prompts\n\n", func() {
+		captureStdout(t, func() {
+			if err := RunPrompts(cfg, false, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	log, err := LoadDayLog(DayFloor(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for q, answers := range log.Answers {
+		for _, ans := range answers {
+			count++
+			at, err := time.Parse(time.RFC3339, ans.Time)
+			if err != nil || at.Second() != 0 {
+				t.Errorf("%s %q saved at %q, want whole minutes", q, ans.Response, ans.Time)
+			}
+		}
+	}
+	if count != 3 {
+		t.Fatalf("saved %d entries, want 3: %+v", count, log.Answers)
+	}
+}
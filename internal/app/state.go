@@ -0,0 +1,88 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// stateFileName lives next to the day files. Its name does not parse as a
+// date, so ListDayFiles never mistakes it for a day.
+const stateFileName = "state.json"
+
+// appState is bookkeeping wlog keeps between runs, as opposed to config the
+// user edits.
+type appState struct {
+	LastViewed string `json:"lastViewed,omitempty"`
+}
+
+func statePath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, stateFileName), nil
+}
+
+func readState() (appState, error) {
+	var state appState
+	path, err := statePath()
+	if err != nil {
+		return state, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("%s: %w", path, err)
+	}
+	return state, nil
+}
+
+func writeState(state appState, settings Settings) error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+	if err := EnsureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	data, err := marshalStored(state, settings.compactStorage)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// lastViewed returns the marker written by `wlog view --since-last`, or nil
+// when it has never run.
+func (s appState) lastViewed() (*time.Time, error) {
+	if s.LastViewed == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s.LastViewed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lastViewed marker %q: %w", s.LastViewed, err)
+	}
+	return &t, nil
+}
+
+// markViewed records at with the configured timestamp precision so an entry
+// stamped in the same second or minute as the view shows up again rather
+// than being missed.
+func markViewed(at time.Time, settings Settings) error {
+	state, err := readState()
+	if err != nil {
+		return err
+	}
+	state.LastViewed = settings.EntryTimestamp(at)
+	return writeState(state, settings)
+}
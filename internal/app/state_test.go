@@ -0,0 +1,69 @@
+package app
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestViewSinceLast(t *testing.T) {
+	dataDir := useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day.AddDate(0, 0, -1), dayWith(day.AddDate(0, 0, -1), "Q", "day before"))
+	writeDay(t, day, dayWith(day, "Q", "standup", "mid-morning", "at the marker", "afternoon"))
+	marker := day.Add(11 * time.Hour).Format(time.RFC3339)
+	writeFile(t, filepath.Join(dataDir, stateFileName), `{"lastViewed":"`+marker+`"}`)
+
+	before := time.Now().Truncate(time.Second)
+	out, err := runOutput(t, "view", "--since-last")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"at the marker", "afternoon"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("since-last missing %q:\n%s", want, out)
+		}
+	}
+	for _, hidden := range []string{"standup", "mid-morning", "day before"} {
+		if strings.Contains(out, hidden) {
+			t.Fatalf("since-last showed %q from before the marker:\n%s", hidden, out)
+		}
+	}
+
+	state, err := readState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewed, err := state.lastViewed()
+	if err != nil || viewed == nil || viewed.Before(before) {
+		t.Fatalf("marker not moved to now: %q, %v", state.LastViewed, err)
+	}
+
+	if _, err := runOutput(t, "view", "--since-last"); !errors.Is(err, ErrNoEntries) {
+		t.Fatalf("second since-last: err = %v, want ErrNoEntries", err)
+	}
+	if _, err := runOutput(t, "view", "--since-last", "yesterday"); err == nil {
+		t.Fatal("--since-last with an interval accepted")
+	}
+}
+
+func TestViewSinceLastWithoutMarker(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Q", "first ever"))
+	out, err := runOutput(t, "view", "--since-last")
+	if err != nil || !strings.Contains(out, "first ever") {
+		t.Fatalf("first since-last should show everything: %v\n%s", err, out)
+	}
+	if state, err := readState(); err != nil || state.LastViewed == "" {
+		t.Fatalf("marker not written: %+v, %v", state, err)
+	}
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"math"
+)
+
+type intervalStats struct {
+	CalendarDays int
+	ActiveDays   int
+	Entries      int
+	PerQuestion  map[string]int
+}
+
+func RunStats(args []string, questions []string) error {
+	activeOnly := false
+	rest := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--ndays-active" {
+			activeOnly = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	opts, err := ParseViewArgs(rest)
+	if err != nil {
+		return err
+	}
+
+	stats, err := collectIntervalStats(opts)
+	if err != nil {
+		return err
+	}
+	if stats.Entries == 0 {
+		fmt.Printf("No entries found for %s.\n", opts.label())
+		return nil
+	}
+
+	fmt.Printf("Entries: %d\n", stats.Entries)
+	for _, q := range OrderQuestions(countsAsAnswers(stats.PerQuestion), questions) {
+		fmt.Printf("  %4d  %s\n", stats.PerQuestion[q], q)
+	}
+	if activeOnly {
+		fmt.Printf("Calendar days: %d\n", stats.CalendarDays)
+		fmt.Printf("Active days: %d (%d%% consistency)\n", stats.ActiveDays, consistencyPercent(stats.ActiveDays, stats.CalendarDays))
+	} else {
+		fmt.Printf("Days: %d\n", stats.CalendarDays)
+	}
+	return nil
+}
+
+func collectIntervalStats(opts ViewOptions) (intervalStats, error) {
+	stats := intervalStats{PerQuestion: make(map[string]int)}
+	start, end, err := opts.bounds()
+	if err != nil {
+		return stats, err
+	}
+	for cursor := start; !cursor.After(end); cursor = cursor.AddDate(0, 0, 1) {
+		stats.CalendarDays++
+		entry, err := opts.readDay(cursor)
+		if err != nil {
+			return stats, err
+		}
+		if entry == nil {
+			continue
+		}
+		log := opts.filterDayLog(*entry)
+		if !dayLogHasEntries(log) {
+			continue
+		}
+		stats.ActiveDays++
+		for q, answers := range log.Answers {
+			stats.Entries += len(answers)
+			if len(answers) > 0 {
+				stats.PerQuestion[q] += len(answers)
+			}
+		}
+	}
+	return stats, nil
+}
+
+func consistencyPercent(active, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return int(math.Round(float64(active) * 100 / float64(total)))
+}
+
+func countsAsAnswers(counts map[string]int) map[string][]Answer {
+	answers := make(map[string][]Answer, len(counts))
+	for q := range counts {
+		answers[q] = nil
+	}
+	return answers
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConsistencyPercent(t *testing.T) {
+	tests := []struct{ active, total, want int }{
+		{3, 7, 43},
+		{7, 7, 100},
+		{0, 7, 0},
+		{1, 3, 33},
+		{2, 3, 67},
+		{0, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := consistencyPercent(tt.active, tt.total); got != tt.want {
+			t.Errorf("consistencyPercent(%d, %d) = %d, want %d", tt.active, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestStatsNDaysActive(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	for _, offset := range []int{0, -2, -6} {
+		day := today.AddDate(0, 0, offset)
+		writeDay(t, day, dayWith(day, "Q", "entry"))
+	}
+	// A day file without entries is not an active day.
+	writeDay(t, today.AddDate(0, 0, -3), DayLog{Mood: 3, Answers: map[string][]Answer{}})
+
+	out, err := runOutput(t, "stats", "--ndays-active", "--days", "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Calendar days: 7\n") || !strings.Contains(out, "Active days: 3 (43% consistency)\n") {
+		t.Fatalf("stats output:\n%s", out)
+	}
+
+	out, err = runOutput(t, "stats", "--days", "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Days: 7\n") || strings.Contains(out, "Active days") {
+		t.Fatalf("stats output without --ndays-active:\n%s", out)
+	}
+}
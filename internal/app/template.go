@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+)
+
+type questionContext struct {
+	Date    string
+	Weekday string
+}
+
+// RenderQuestion expands text/template actions such as {{.Date}} and
+// {{.Weekday}} in a question for the given day. Questions without template
+// actions, or with invalid ones, are returned unchanged so the raw text can
+// keep serving as the answers key.
+func RenderQuestion(question string, day time.Time) string {
+	if !strings.Contains(question, "{{") {
+		return question
+	}
+	tmpl, err := template.New("question").Parse(question)
+	if err != nil {
+		return question
+	}
+	var b strings.Builder
+	ctx := questionContext{Date: day.Format("2006-01-02"), Weekday: day.Weekday().String()}
+	if err := tmpl.Execute(&b, ctx); err != nil {
+		return question
+	}
+	return b.String()
+}
+
+// formatDay is the value passed to a view --format template. Answers is keyed
+// by question text, and Questions lists those keys in display order.
+type formatDay struct {
+	Date      string
+	Questions []string
+	Answers   map[string][]Answer
+}
+
+func parseViewFormat(format string, settings Settings) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"time": settings.DisplayTime,
+	}
+	tmpl, err := template.New("format").Funcs(funcs).Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func executeViewFormat(w io.Writer, tmpl *template.Template, log DayLog, questions []string) error {
+	day := formatDay{
+		Date:      log.Date,
+		Questions: OrderQuestions(log.Answers, questions),
+		Answers:   log.Answers,
+	}
+	if err := tmpl.Execute(w, day); err != nil {
+		return fmt.Errorf("render --format template for %s: %w", log.Date, err)
+	}
+	return nil
+}
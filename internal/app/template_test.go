@@ -0,0 +1,89 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderQuestion(t *testing.T) {
+	day := mustDay(t, "2026-03-02")
+	tests := []struct {
+		question string
+		want     string
+	}{
+		{"What did you do on {{.Date}}?", "What did you do on 2026-03-02?"},
+		{"{{.Weekday}} plans?", "Monday plans?"},
+		{"Plain question?", "Plain question?"},
+		{"Braces { and } stay", "Braces { and } stay"},
+		{"Broken {{.Date", "Broken {{.Date"},
+		{"Unknown {{.Nope}}", "Unknown {{.Nope}}"},
+	}
+	for _, tt := range tests {
+		if got := RenderQuestion(tt.question, day); got != tt.want {
+			t.Errorf("RenderQuestion(%q) = %q, want %q", tt.question, got, tt.want)
+		}
+	}
+}
+
+func TestPromptsKeyTemplatedQuestionByRawText(t *testing.T) {
+	useTempDirs(t)
+	question := "What did you do on {{.Weekday}}?"
+	var out string
+	withStdin(t, "shipped\n", func() {
+		out = captureStdout(t, func() {
+			if err := RunPrompts(Config{Questions: []string{question}}, false, false); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+	today := DayFloor(time.Now())
+	if want := "What did you do on " + today.Weekday().String() + "?"; !strings.Contains(out, want) {
+		t.Fatalf("prompt did not render the question:\n%s", out)
+	}
+	log, err := LoadDayLog(today)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Answers[question]) != 1 {
+		t.Fatalf("answer not keyed by the raw question: %+v", log.Answers)
+	}
+}
+
+func TestViewFormatTemplate(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"B?", "A?"}, DisplayTimezone: "UTC"}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, DayLog{Answers: map[string][]Answer{
+		"A?": {{Time: "2026-03-02T09:05:00Z", Response: "alpha"}},
+		"B?": {{Time: "2026-03-02T10:30:00Z", Response: "beta"}, {Time: "2026-03-02T11:00:00Z", Response: "gamma"}},
+	}})
+
+	format := `{{.Date}}:{{range $q := .Questions}} {{$q}}{{range index $.Answers $q}} {{time .Time}}={{.Response}}{{end}}{{end}}` + "\n"
+	out, err := runOutput(t, "view", "--format", format, "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2026-03-02: B? 10:30=beta 11:00=gamma A? 09:05=alpha\n"; out != want {
+		t.Fatalf("--format output = %q, want %q", out, want)
+	}
+}
+
+func TestViewFormatParseError(t *testing.T) {
+	useTempDirs(t)
+	writeDay(t, mustDay(t, "2026-03-02"), dayWith(mustDay(t, "2026-03-02"), "Q", "x"))
+	out, err := runOutput(t, "view", "--format", "{{.Date", "--days", "5000")
+	if err == nil || !strings.Contains(err.Error(), "invalid --format template") {
+		t.Fatalf("error = %v, want a template parse error", err)
+	}
+	if out != "" {
+		t.Fatalf("output before the parse error:\n%s", out)
+	}
+
+	_, err = runOutput(t, "view", "--format", "{{.Missing}}", "--days", "5000")
+	if err == nil || !strings.Contains(err.Error(), "render --format template for 2026-03-02") {
+		t.Fatalf("error = %v, want a render error naming the day", err)
+	}
+}
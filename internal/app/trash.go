@@ -0,0 +1,107 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+// TrashedAnswer is an answer removed while softDelete was on. It keeps the
+// question it was filed under so `wlog trash restore` can put it back.
+type TrashedAnswer struct {
+	Question  string `json:"question"`
+	DeletedAt string `json:"deletedAt"`
+	Answer
+}
+
+// DeleteAnswer removes the answer at idx under question, dropping the
+// question once it has no answers left. With softDelete the answer is moved
+// to the day's trash instead of being discarded.
+func DeleteAnswer(log *DayLog, question string, idx int, softDelete bool) bool {
+	answers := log.Answers[question]
+	if idx < 0 || idx >= len(answers) {
+		return false
+	}
+	if softDelete {
+		TrashAnswers(log, question, answers[idx:idx+1])
+	}
+	answers = append(answers[:idx], answers[idx+1:]...)
+	if len(answers) == 0 {
+		delete(log.Answers, question)
+	} else {
+		log.Answers[question] = answers
+	}
+	return true
+}
+
+// TrashAnswers adds answers, already removed from question by the caller, to
+// the day's trash.
+func TrashAnswers(log *DayLog, question string, answers []Answer) {
+	deletedAt := time.Now().Format(time.RFC3339)
+	for _, ans := range answers {
+		log.Trash = append(log.Trash, TrashedAnswer{Question: question, DeletedAt: deletedAt, Answer: ans})
+	}
+}
+
+// restoreTrash moves every trashed answer back under its question in time
+// order and empties the trash. It returns the restored answers.
+func restoreTrash(log *DayLog) []plannedAnswer {
+	var restored []plannedAnswer
+	for _, trashed := range log.Trash {
+		if log.Answers == nil {
+			log.Answers = make(map[string][]Answer)
+		}
+		log.Answers[trashed.Question] = insertByTime(log.Answers[trashed.Question], trashed.Answer)
+		restored = append(restored, plannedAnswer{Question: trashed.Question, Answer: trashed.Answer})
+	}
+	log.Trash = nil
+	return restored
+}
+
+// RunTrash lists the trashed answers of a day, or restores them with
+// `wlog trash restore [date]`. The day defaults to today.
+func RunTrash(args []string, settings Settings, dryRun bool) error {
+	restore := len(args) > 0 && args[0] == "restore"
+	if restore {
+		args = args[1:]
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("usage: wlog trash [restore] [date]")
+	}
+	day := DayFloor(time.Now())
+	if len(args) == 1 {
+		var err error
+		if day, err = parseAddDate(args[0]); err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD or a single day like \"yesterday\"", args[0])
+		}
+	}
+	log, err := ReadDayLogIfExists(day)
+	if err != nil {
+		return err
+	}
+	date := day.Format("2006-01-02")
+	if log == nil || len(log.Trash) == 0 {
+		fmt.Printf("Trash is empty for %s.\n", date)
+		return nil
+	}
+
+	if !restore {
+		for _, trashed := range log.Trash {
+			fmt.Printf("[%s] %s: %s (deleted %s)\n", settings.DisplayTime(trashed.Time), trashed.Question, trashed.Response, settings.DisplayTime(trashed.DeletedAt))
+		}
+		return nil
+	}
+	restored := restoreTrash(log)
+	if dryRun {
+		path, err := DayFilePath(day)
+		if err != nil {
+			return err
+		}
+		printPlannedWrite(path, restored, settings)
+		return nil
+	}
+	if err := SaveDayLog(day, *log, settings); err != nil {
+		return err
+	}
+	fmt.Printf("Restored %d %s on %s.\n", len(restored), pluralize(len(restored), "entry", "entries"), date)
+	return nil
+}
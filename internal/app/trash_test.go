@@ -0,0 +1,80 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDeleteAnswer(t *testing.T) {
+	for _, soft := range []bool{false, true} {
+		log := dayWith(mustDay(t, "2026-03-02"), "Q", "a", "b")
+		if DeleteAnswer(&log, "Q", 5, soft) || DeleteAnswer(&log, "Missing", 0, soft) {
+			t.Fatal("deleted an answer that does not exist")
+		}
+		if !DeleteAnswer(&log, "Q", 0, soft) || len(log.Answers["Q"]) != 1 || log.Answers["Q"][0].Response != "b" {
+			t.Fatalf("soft=%v: answers after delete = %+v", soft, log.Answers)
+		}
+		DeleteAnswer(&log, "Q", 0, soft)
+		if _, ok := log.Answers["Q"]; ok {
+			t.Fatalf("soft=%v: empty question kept", soft)
+		}
+		var trashed []string
+		for _, ans := range log.Trash {
+			if ans.Question != "Q" || ans.DeletedAt == "" {
+				t.Errorf("bad trash entry %+v", ans)
+			}
+			trashed = append(trashed, ans.Response)
+		}
+		if want := map[bool][]string{true: {"a", "b"}}[soft]; !reflect.DeepEqual(trashed, want) {
+			t.Fatalf("soft=%v: trash = %v, want %v", soft, trashed, want)
+		}
+	}
+}
+
+func TestRunTrashListAndRestore(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "first", "second", "third")
+	DeleteAnswer(&log, "Q", 1, true)
+	DeleteAnswer(&log, "Q", 0, true)
+	writeDay(t, day, log)
+
+	out, err := runOutput(t, "cat", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "first") || strings.Contains(out, "second") || !strings.Contains(out, "third") {
+		t.Fatalf("view showed trashed entries:\n%s", out)
+	}
+
+	out, err = runOutput(t, "trash", "2026-03-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "[10:00] Q: second (deleted ") || !strings.Contains(out, "[09:00] Q: first (deleted ") {
+		t.Fatalf("trash listing:\n%s", out)
+	}
+
+	out, err = runOutput(t, "trash", "restore", "2026-03-02")
+	if err != nil || out != "Restored 2 entries on 2026-03-02.\n" {
+		t.Fatalf("restore: %q, %v", out, err)
+	}
+	restored, err := LoadDayLog(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ans := range restored.Answers["Q"] {
+		got = append(got, ans.Response)
+	}
+	if want := []string{"first", "second", "third"}; !reflect.DeepEqual(got, want) || len(restored.Trash) != 0 {
+		t.Fatalf("restored = %v with trash %+v, want %v and no trash", got, restored.Trash, want)
+	}
+	if out, _ := runOutput(t, "trash", "2026-03-02"); out != "Trash is empty for 2026-03-02.\n" {
+		t.Fatalf("trash after restore = %q", out)
+	}
+}
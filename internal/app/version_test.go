@@ -0,0 +1,37 @@
+package app
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRunVersionJSON(t *testing.T) {
+	build := BuildInfo{Commit: "abc1234", Ref: "main", Version: "v1.2.3"}
+	out := captureStdout(t, func() {
+		if err := RunVersion([]string{"--json"}, build); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if want := `{"commit":"abc1234","ref":"main","version":"v1.2.3"}` + "\n"; out != want {
+		t.Fatalf("output = %q, want %q", out, want)
+	}
+	var decoded BuildInfo
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil || decoded != build {
+		t.Fatalf("decoded %+v, %v", decoded, err)
+	}
+}
+
+func TestRunVersionText(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := RunVersion(nil, BuildInfo{Commit: "abc1234", Ref: "main", Version: "v1.2.3"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out != "wlog abc1234 main v1.2.3\n" {
+		t.Fatalf("output = %q", out)
+	}
+	if err := RunVersion([]string{"--yaml"}, BuildInfo{}); err == nil || !strings.Contains(err.Error(), "--yaml") {
+		t.Fatalf("unknown flag error = %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dayWith returns a day log for day with one answer per response under
+// question, logged an hour apart from 09:00.
+func dayWith(day time.Time, question string, responses ...string) DayLog {
+	answers := make([]Answer, 0, len(responses))
+	for i, resp := range responses {
+		answers = append(answers, Answer{Time: day.Add(time.Duration(9+i) * time.Hour).Format(time.RFC3339), Response: resp})
+	}
+	return DayLog{Answers: map[string][]Answer{question: answers}}
+}
+
+// runOutput runs the CLI with args and returns its stdout and error.
+func runOutput(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var err error
+	out := captureStdout(t, func() {
+		err = Run(args, BuildInfo{})
+	})
+	return out, err
+}
+
+func TestViewUsesDefaultViewInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval string
+		args     []string
+		want     string
+		notWant  string
+	}{
+		{"configured interval", "yesterday", []string{"view"}, "from yesterday", "from today"},
+		{"explicit interval wins", "yesterday", []string{"view", "today"}, "from today", "from yesterday"},
+		{"range", "last 2 days", []string{"view"}, "from yesterday", ""},
+		{"unset", "", []string{"view"}, "from today", "from yesterday"},
+		{"invalid falls back to today", "someday", []string{"view"}, "from today", "from yesterday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempDirs(t)
+			if err := SaveConfig(Config{Questions: []string{"Q"}, DefaultViewInterval: tt.interval}); err != nil {
+				t.Fatal(err)
+			}
+			today := DayFloor(time.Now())
+			writeDay(t, today, dayWith(today, "Q", "from today"))
+			writeDay(t, today.AddDate(0, 0, -1), dayWith(today.AddDate(0, 0, -1), "Q", "from yesterday"))
+
+			out, err := runOutput(t, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(out, tt.want) || (tt.notWant != "" && strings.Contains(out, tt.notWant)) {
+				t.Fatalf("output:\n%s\nwant %q and not %q", out, tt.want, tt.notWant)
+			}
+		})
+	}
+}
+
+func TestCatPlainIsStable(t *testing.T) {
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "first", "second")
+	log.Mood = 4
+	opts := ViewOptions{Plain: true}
+	const golden = "Mon 2026-03-02\n\nMood: 4/5\n\n[0] Q\n    - [09:00] first\n    - [10:00] second\n[1] Empty\n\n"
+
+	defer func(orig func() time.Time) { now = orig }(now)
+	var fancy []string
+	for _, at := range []time.Time{day.Add(20 * time.Hour), day.AddDate(0, 0, 1).Add(8 * time.Hour)} {
+		now = func() time.Time { return at }
+		if got := renderListView(day, log, []string{"Q", "Empty"}, opts); got != golden {
+			t.Fatalf("plain output at %s:\n%q\nwant\n%q", at, got, golden)
+		}
+		fancy = append(fancy, renderListView(day, log, []string{"Q", "Empty"}, ViewOptions{}))
+	}
+	if fancy[0] == fancy[1] || !strings.Contains(fancy[0], "Today") || !strings.Contains(fancy[0], "(2)") {
+		t.Fatalf("default output should carry the relative label and counts:\n%s\n%s", fancy[0], fancy[1])
+	}
+}
+
+func TestViewEmptyReportsGaps(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	today := DayFloor(time.Now())
+	for _, offset := range []int{-3, -1} {
+		day := today.AddDate(0, 0, offset)
+		writeDay(t, day, dayWith(day, "Q", "worked"))
+	}
+
+	out, err := runOutput(t, "view", "--empty", "--days", "4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for offset := -3; offset <= 0; offset++ {
+		gap := today.AddDate(0, 0, offset).Format("2006-01-02") + " — no entries"
+		if want := offset == -2 || offset == 0; strings.Contains(out, gap) != want {
+			t.Errorf("gap line for day %d present = %v, want %v:\n%s", offset, !want, want, out)
+		}
+	}
+	if strings.Count(out, "worked") != 2 {
+		t.Fatalf("logged days missing:\n%s", out)
+	}
+
+	out, err = runOutput(t, "view", "--days", "4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "no entries") {
+		t.Fatalf("gaps reported without --empty:\n%s", out)
+	}
+}
+
+func TestCatNoEmptyQuestions(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Empty?", "Worked?", "Blocked?"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Worked?", "shipped"))
+
+	out, err := runOutput(t, "cat", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "[0] Empty?") || !strings.Contains(out, "[2] Blocked?") {
+		t.Fatalf("cat without the flag dropped empty questions:\n%s", out)
+	}
+	out, err = runOutput(t, "cat", "--no-empty-questions", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "Empty?") || strings.Contains(out, "Blocked?") {
+		t.Fatalf("--no-empty-questions kept empty questions:\n%s", out)
+	}
+	if !strings.Contains(out, "[1] Worked?") || !strings.Contains(out, "shipped") {
+		t.Fatalf("--no-empty-questions lost the answered question:\n%s", out)
+	}
+}
+
+func TestRangeSkipsCorruptDayFile(t *testing.T) {
+	dataDir := useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, date := range []string{"2026-03-01", "2026-03-03"} {
+		day := mustDay(t, date)
+		writeDay(t, day, dayWith(day, "Q", "note from "+date))
+	}
+	writeFile(t, filepath.Join(dataDir, "2026-03-02.json"), "{not json")
+
+	for _, args := range [][]string{
+		{"view", "--days", "5000"},
+		{"cat", "--days", "5000"},
+		{"grep", "note from"},
+	} {
+		var out string
+		var err error
+		warnings := captureStderr(t, func() {
+			out, err = runOutput(t, args...)
+		})
+		if err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+		if !strings.Contains(out, "note from 2026-03-01") || !strings.Contains(out, "note from 2026-03-03") {
+			t.Fatalf("%v lost the readable days:\n%s", args, out)
+		}
+		if !strings.Contains(warnings, "2026-03-02.json") {
+			t.Fatalf("%v: warning does not name the corrupt file: %q", args, warnings)
+		}
+
+		strict := append([]string{args[0], "--strict"}, args[1:]...)
+		if _, err := runOutput(t, strict...); err == nil || !strings.Contains(err.Error(), "2026-03-02.json") {
+			t.Fatalf("%v error = %v, want one naming the corrupt file", strict, err)
+		}
+	}
+}
+
+func TestViewJSONCompactAndPretty(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, date := range []string{"2026-03-02", "2026-03-03"} {
+		day := mustDay(t, date)
+		writeDay(t, day, dayWith(day, "Q", "one", "two"))
+	}
+
+	compact, err := runOutput(t, "view", "--json", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(compact, "\n") != 1 || !strings.HasSuffix(compact, "\n") {
+		t.Fatalf("--json is not a single line:\n%s", compact)
+	}
+	pretty, err := runOutput(t, "view", "--json-pretty", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(pretty, "\n  {\n") {
+		t.Fatalf("--json-pretty is not indented:\n%s", pretty)
+	}
+
+	var a, b []DayLog
+	if err := json.Unmarshal([]byte(compact), &a); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(pretty), &b); err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 2 || !reflect.DeepEqual(a, b) {
+		t.Fatalf("compact and pretty output differ:\n%+v\n%+v", a, b)
+	}
+
+	out, err := runOutput(t, "view", "--json", "tomorrow")
+	if !errors.Is(err, ErrNoEntries) || out != "[]\n" {
+		t.Fatalf("empty --json: %q, %v", out, err)
+	}
+}
+
+func TestOnlyTodayQuestionsHidesExtras(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	log := dayWith(day, "Q", "configured answer")
+	log.Answers["Retired?"] = []Answer{{Time: day.Add(11 * time.Hour).Format(time.RFC3339), Response: "extra answer"}}
+	writeDay(t, day, log)
+
+	for _, cmd := range []string{"view", "cat"} {
+		out, err := runOutput(t, cmd, "--days", "5000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out, "Retired?") || !strings.Contains(out, "extra answer") {
+			t.Fatalf("%s without the flag hid the extra question:\n%s", cmd, out)
+		}
+		for _, flag := range []string{"--only-today-questions", "--config-only"} {
+			out, err := runOutput(t, cmd, flag, "--days", "5000")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(out, "Retired?") || strings.Contains(out, "extra answer") {
+				t.Fatalf("%s %s kept the extra question:\n%s", cmd, flag, out)
+			}
+			if !strings.Contains(out, "configured answer") {
+				t.Fatalf("%s %s lost the configured question:\n%s", cmd, flag, out)
+			}
+		}
+	}
+}
+
+func TestViewReverseEntries(t *testing.T) {
+	useTempDirs(t)
+	if err := SaveConfig(Config{Questions: []string{"Q"}}); err != nil {
+		t.Fatal(err)
+	}
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Q", "first", "second", "third"))
+	stored := readDayFile(t, day)
+
+	for _, cmd := range []string{"view", "cat"} {
+		out, err := runOutput(t, cmd, "--reverse-entries", "--days", "5000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, second, third := strings.Index(out, "first"), strings.Index(out, "second"), strings.Index(out, "third")
+		if first < 0 || third > second || second > first {
+			t.Fatalf("%s --reverse-entries not newest first:\n%s", cmd, out)
+		}
+		out, err = runOutput(t, cmd, "--days", "5000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Index(out, "first") > strings.Index(out, "third") {
+			t.Fatalf("%s without the flag is not oldest first:\n%s", cmd, out)
+		}
+	}
+	if got := readDayFile(t, day); got != stored {
+		t.Fatalf("reversing the view rewrote the day file:\n%s", got)
+	}
+
+	answers := dayWith(day, "Q", "a", "b").Answers["Q"]
+	if got := (ViewOptions{ReverseEntries: true}).entryOrder(answers); got[0].Response != "b" || answers[0].Response != "a" {
+		t.Fatalf("entryOrder = %+v, input = %+v", got, answers)
+	}
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const defaultWordsTop = 20
+
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "did": true, "do": true, "for": true,
+	"from": true, "had": true, "has": true, "have": true, "i": true, "if": true,
+	"in": true, "is": true, "it": true, "its": true, "me": true, "my": true,
+	"of": true, "on": true, "or": true, "so": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "we": true, "were": true, "with": true,
+}
+
+type wordCount struct {
+	Word  string
+	Count int
+}
+
+func RunWords(args []string) error {
+	top := defaultWordsTop
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		name, _, _ := strings.Cut(args[i], "=")
+		if name != "--top" {
+			rest = append(rest, args[i])
+			continue
+		}
+		value, err := flagValue(args, &i)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid --top value %q, expected a positive number", value)
+		}
+		top = n
+	}
+	opts, err := ParseViewArgs(rest)
+	if err != nil {
+		return err
+	}
+
+	logs, err := collectDayLogs(opts)
+	if err != nil {
+		return err
+	}
+	counts := topWords(computeWordFrequency(logs), top)
+	if len(counts) == 0 {
+		fmt.Printf("No entries found for %s.\n", opts.label())
+		return nil
+	}
+	for _, wc := range counts {
+		fmt.Printf("%6d  %s\n", wc.Count, wc.Word)
+	}
+	return nil
+}
+
+// computeWordFrequency counts lowercased words across every response,
+// ignoring punctuation and the stopwords list.
+func computeWordFrequency(logs []DayLog) map[string]int {
+	counts := make(map[string]int)
+	for _, log := range logs {
+		for _, answers := range log.Answers {
+			for _, ans := range answers {
+				for _, word := range tokenize(ans.Response) {
+					if !stopwords[word] {
+						counts[word]++
+					}
+				}
+			}
+		}
+	}
+	return counts
+}
+
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	words := fields[:0]
+	for _, field := range fields {
+		if word := strings.Trim(field, "'"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// topWords returns the n most frequent words, breaking ties alphabetically.
+func topWords(counts map[string]int, n int) []wordCount {
+	list := make([]wordCount, 0, len(counts))
+	for word, count := range counts {
+		list = append(list, wordCount{Word: word, Count: count})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].Word < list[j].Word
+	})
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeWordFrequency(t *testing.T) {
+	logs := []DayLog{
+		{Answers: map[string][]Answer{
+			"Done?": {{Response: "Fixed the login bug, and reviewed the PR."}, {Response: "Login page: don't break it!"}},
+		}},
+		{Answers: map[string][]Answer{
+			"Next?":    {{Response: "Review 'login' flow in the app"}},
+			"Blocked?": {{Response: "nothing"}},
+		}},
+	}
+	want := map[string]int{
+		"fixed": 1, "login": 3, "bug": 1, "reviewed": 1, "pr": 1, "page": 1,
+		"don't": 1, "break": 1, "review": 1, "flow": 1, "app": 1, "nothing": 1,
+	}
+	if got := computeWordFrequency(logs); !reflect.DeepEqual(got, want) {
+		t.Fatalf("counts = %v, want %v", got, want)
+	}
+}
+
+func TestTopWords(t *testing.T) {
+	counts := map[string]int{"login": 3, "bug": 2, "app": 2, "flow": 1}
+	want := []wordCount{{"login", 3}, {"app", 2}, {"bug", 2}}
+	if got := topWords(counts, 3); !reflect.DeepEqual(got, want) {
+		t.Fatalf("topWords = %v, want %v", got, want)
+	}
+	if got := topWords(counts, 10); len(got) != 4 {
+		t.Fatalf("topWords(10) = %v, want all 4 words", got)
+	}
+}
+
+func TestRunWords(t *testing.T) {
+	useTempDirs(t)
+	day := mustDay(t, "2026-03-02")
+	writeDay(t, day, dayWith(day, "Q", "deploy the api", "API deploy", "write docs"))
+	out, err := runOutput(t, "words", "--top", "2", "--days", "5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "     2  api\n     2  deploy\n"; out != want {
+		t.Fatalf("words output = %q, want %q", out, want)
+	}
+	if _, err := runOutput(t, "words", "--top", "0"); err == nil {
+		t.Fatal("--top 0 accepted")
+	}
+}
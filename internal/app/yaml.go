@@ -0,0 +1,126 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML configs are decoded into the same shapes encoding/json produces
+// (map[string]any, []any, float64, bool, string and nil), so the rest of the
+// config code does not care which format the file was in.
+
+func decodeYAML(data []byte) (map[string]any, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return map[string]any{}, nil
+	}
+	value, err := yamlNodeValue(doc.Content[0])
+	if err != nil {
+		return nil, err
+	}
+	switch root := value.(type) {
+	case map[string]any:
+		return root, nil
+	case nil:
+		return map[string]any{}, nil
+	}
+	return nil, fmt.Errorf("line %d: expected a mapping at the top level", doc.Content[0].Line)
+}
+
+// yamlOldBools are the YAML 1.1 booleans that yaml.v3 reads as strings.
+// Hand-written configs use them, so unquoted ones are read as bools.
+var yamlOldBools = map[string]bool{"yes": true, "on": true, "no": false, "off": false}
+
+func yamlNodeValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return yamlNodeValue(node.Alias)
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode := node.Content[i]
+			if keyNode.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: mapping keys must be scalars", keyNode.Line)
+			}
+			if _, dup := m[keyNode.Value]; dup {
+				return nil, fmt.Errorf("line %d: duplicate key %q", keyNode.Line, keyNode.Value)
+			}
+			value, err := yamlNodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[keyNode.Value] = value
+		}
+		return m, nil
+	case yaml.SequenceNode:
+		items := make([]any, 0, len(node.Content))
+		for _, child := range node.Content {
+			value, err := yamlNodeValue(child)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		return items, nil
+	case yaml.ScalarNode:
+		return yamlScalarValue(node)
+	}
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
+
+func yamlScalarValue(node *yaml.Node) (any, error) {
+	if node.Style == 0 && node.ShortTag() == "!!str" {
+		if b, ok := yamlOldBools[strings.ToLower(node.Value)]; ok {
+			return b, nil
+		}
+	}
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		err := node.Decode(&b)
+		return b, err
+	case "!!int", "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		return f, nil
+	}
+	// Everything else, timestamps and binary included, stays as written.
+	return node.Value, nil
+}
+
+func encodeYAML(raw map[string]any) ([]byte, error) {
+	// Round-trip through JSON so every value is one of the types produced by
+	// encoding/json, whatever the caller put in the map.
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	if normalized == nil {
+		normalized = map[string]any{}
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(normalized); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
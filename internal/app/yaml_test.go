@@ -0,0 +1,228 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]any
+	}{
+		{"empty", "", map[string]any{}},
+		{"only comments", "# nothing\n---\n", map[string]any{}},
+		{
+			name: "scalars",
+			data: "name: wlog\ncount: 3\nratio: 0.5\non: true\noff: false\nnothing: null\ntilde: ~\nblank:\n",
+			want: map[string]any{"name": "wlog", "count": 3.0, "ratio": 0.5, "on": true, "off": false, "nothing": nil, "tilde": nil, "blank": nil},
+		},
+		{
+			name: "double quoted",
+			data: `a: "true"` + "\n" + `b: "12"` + "\n" + `c: "tab\there \"q\" \u00e9"` + "\n" + `d: "# not a comment"` + "\n",
+			want: map[string]any{"a": "true", "b": "12", "c": "tab\there \"q\" é", "d": "# not a comment"},
+		},
+		{
+			name: "single quoted",
+			data: "a: 'it''s'\nb: '\\n stays'\nc: 'x' # trailing\n",
+			want: map[string]any{"a": "it's", "b": `\n stays`, "c": "x"},
+		},
+		{
+			name: "quoted keys",
+			data: "\"has: colon\": 1\n'#hash': 2\n",
+			want: map[string]any{"has: colon": 1.0, "#hash": 2.0},
+		},
+		{
+			name: "comments",
+			data: "# header\nname: wlog # inline\n\n  # indented comment\nurl: http://x#frag\n",
+			want: map[string]any{"name": "wlog", "url": "http://x#frag"},
+		},
+		{
+			name: "nested mapping",
+			data: "outer:\n  inner:\n    deep: 1\n  sibling: two\ntop: 3\n",
+			want: map[string]any{"outer": map[string]any{"inner": map[string]any{"deep": 1.0}, "sibling": "two"}, "top": 3.0},
+		},
+		{
+			name: "indented list",
+			data: "questions:\n  - What did you do?\n  - \"Blockers: any?\"\n  - 'quoted'\n  - 42\n",
+			want: map[string]any{"questions": []any{"What did you do?", "Blockers: any?", "quoted", 42.0}},
+		},
+		{
+			name: "list at key indentation",
+			data: "questions:\n- one\n- two\nnext: 1\n",
+			want: map[string]any{"questions": []any{"one", "two"}, "next": 1.0},
+		},
+		{
+			name: "empty collections",
+			data: "list: []\nmap: {}\n",
+			want: map[string]any{"list": []any{}, "map": map[string]any{}},
+		},
+		{
+			name: "windows line endings",
+			data: "a: 1\r\nb:\r\n  - x\r\n",
+			want: map[string]any{"a": 1.0, "b": []any{"x"}},
+		},
+		{
+			name: "document marker",
+			data: "---\na: 1\n",
+			want: map[string]any{"a": 1.0},
+		},
+		{
+			name: "flow collections",
+			data: "questions: [Done, \"Next: what?\", 3]\nstyle: {color: red}\n",
+			want: map[string]any{"questions": []any{"Done", "Next: what?", 3.0}, "style": map[string]any{"color": "red"}},
+		},
+		{
+			name: "block scalars",
+			data: "folded: >\n  one\n  two\nliteral: |\n  one\n  two\n",
+			want: map[string]any{"folded": "one two\n", "literal": "one\ntwo\n"},
+		},
+		{
+			name: "yaml 1.1 booleans",
+			data: "a: yes\nb: No\nc: on\nd: off\ne: \"yes\"\nf: 'no'\n",
+			want: map[string]any{"a": true, "b": false, "c": true, "d": false, "e": "yes", "f": "no"},
+		},
+		{
+			name: "anchors and mappings in lists",
+			data: "base: &b 1\ncopy: *b\nlist:\n  - name: x\n",
+			want: map[string]any{"base": 1.0, "copy": 1.0, "list": []any{map[string]any{"name": "x"}}},
+		},
+		{
+			name: "timestamps stay strings",
+			data: "since: 2026-03-02\n",
+			want: map[string]any{"since": "2026-03-02"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeYAML([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("decodeYAML = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"tab indent", "a:\n\t- x\n", "line 2"},
+		{"top-level list", "- a\n- b\n", "expected a mapping"},
+		{"top-level scalar", "just text\n", "expected a mapping"},
+		{"duplicate key", "a: 1\na: 2\n", `duplicate key "a"`},
+		{"bad indentation", "a: 1\n  b: 2\n", "line 2"},
+		{"unterminated", "a: \"open\n", "line 2"},
+		{"text after quote", "a: \"x\" y\n", "did not find expected key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeYAML([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("decodeYAML error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeYAML(t *testing.T) {
+	raw := map[string]any{
+		"questions": []string{"B?", "A: really?"},
+		"nested":    map[string]any{"z": 1, "a": map[string]any{}},
+		"empty":     []any{},
+		"flag":      true,
+		"ratio":     0.25,
+		"none":      nil,
+	}
+	got, err := encodeYAML(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `empty: []
+flag: true
+nested:
+  a: {}
+  z: 1
+none: null
+questions:
+  - B?
+  - 'A: really?'
+ratio: 0.25
+`
+	if string(got) != want {
+		t.Fatalf("encodeYAML =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteConfigMapYAMLRoundTrip(t *testing.T) {
+	tests := []map[string]any{
+		{},
+		{"questions": []any{"What did you do?", "Blockers: any?", "# hash", "true", " padded "}},
+		{"questionStyles": map[string]any{"What did you do?": map[string]any{"color": "#ff0000"}}, "schemaVersion": 1.0},
+		{"locale": "de-DE", "compactStorage": false, "timestampPrecision": "minute", "missing": nil},
+		{"_defaultQuestions": []any{}, "quoted'key": "it's", "unicode": "naïve — ok"},
+		{"strings": []any{"yes", "no", "on", "12", "1e3", "~", "null", "", "- item", "a #b", "line\nbreak", "2026-03-02"}},
+		{"nested": []any{[]any{1.0, "x"}, map[string]any{"a": true}}},
+		{"deep": map[string]any{"a": map[string]any{"b": map[string]any{"c": []any{1.5, false, nil}}}}},
+	}
+	for i, raw := range tests {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := writeConfigMap(path, raw); err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+		back, err := readConfigMap(path)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+		if !reflect.DeepEqual(back, raw) {
+			t.Errorf("case %d: round trip = %#v, want %#v", i, back, raw)
+		}
+	}
+}
+
+func TestYAMLConfigRoundTrip(t *testing.T) {
+	useTempDirs(t)
+	path := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv("WLOG_CONFIG_FILE", path)
+	want := Config{
+		Questions:      []string{"What did you do?", "Blockers: any?", "yes"},
+		OrderedAnswers: boolPtr(true),
+		Locale:         "de-DE",
+	}
+	if err := SaveConfig(want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Questions, want.Questions) || !got.OrderedAnswersEnabled() || got.Locale != "de-DE" {
+		t.Fatalf("config round trip = %+v", got)
+	}
+}
+
+func TestLoadHandWrittenYAMLConfig(t *testing.T) {
+	useTempDirs(t)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("WLOG_CONFIG_FILE", path)
+	data := "questions: [\"Done?\", \"Next: what?\"]\norderedAnswers: yes\nnoEntriesMessage: >\n  Nothing logged\n  for %s.\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg.Questions, []string{"Done?", "Next: what?"}) || !cfg.OrderedAnswersEnabled() || cfg.NoEntriesMessage != "Nothing logged for %s." {
+		t.Fatalf("hand-written YAML config = %+v", cfg)
+	}
+}
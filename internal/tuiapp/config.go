@@ -19,6 +19,7 @@ const (
 	cfgRowAddQuestion
 	cfgRowBool
 	cfgRowInt
+	cfgRowString
 )
 
 type configField int
@@ -33,6 +34,22 @@ const (
 	cfgFieldConfirmEscapeWithText
 	cfgFieldStatusDuration
 	cfgFieldEscapeConfirmTimeout
+	cfgFieldDefaultViewInterval
+	cfgFieldDedupeEntries
+	cfgFieldEntrySoftLimit
+	cfgFieldSkipPlaceholder
+	cfgFieldCompactStorage
+	cfgFieldDisplayTimezone
+	cfgFieldShuffleQuestions
+	cfgFieldStatusPosition
+	cfgFieldNoEntriesMessage
+	cfgFieldWarnResponseLen
+	cfgFieldTimestampPrecision
+	cfgFieldLocale
+	cfgFieldSilentEmpty
+	cfgFieldSoftDelete
+	cfgFieldAllowFutureEntries
+	cfgFieldOrderedAnswers
 )
 
 type configRow struct {
@@ -43,6 +60,9 @@ type configRow struct {
 
 type configValues struct {
 	Questions                     []string
+	QuestionStyles                map[string]app.QuestionStyle
+	CarryQuestions                []string
+	Editors                       []string
 	ShowHints                     bool
 	ShowHintsCustom               bool
 	AutoInsert                    bool
@@ -61,11 +81,46 @@ type configValues struct {
 	StatusDurationSet             bool
 	EscapeConfirmTimeout          int
 	EscapeConfirmTimeoutSet       bool
+	DefaultViewInterval           string
+	DefaultViewIntervalSet        bool
+	DedupeEntries                 bool
+	DedupeEntriesCustom           bool
+	EntrySoftLimit                int
+	EntrySoftLimitSet             bool
+	SkipPlaceholder               string
+	SkipPlaceholderSet            bool
+	compactStorage                bool
+	compactStorageCustom          bool
+	displayTimezone               string
+	displayTimezoneSet            bool
+	shuffleQuestions              bool
+	shuffleQuestionsCustom        bool
+	statusPosition                string
+	statusPositionSet             bool
+	noEntriesMessage              string
+	noEntriesMessageSet           bool
+	WarnResponseLen               int
+	WarnResponseLenSet            bool
+	timestampPrecision            string
+	timestampPrecisionSet         bool
+	locale                        string
+	localeSet                     bool
+	silentEmpty                   bool
+	silentEmptyCustom             bool
+	softDelete                    bool
+	softDeleteCustom              bool
+	allowFutureEntries            bool
+	allowFutureEntriesCustom      bool
+	orderedAnswers                bool
+	orderedAnswersCustom          bool
 }
 
 func newConfigValues(cfg app.Config) configValues {
 	values := configValues{
 		Questions:                     append([]string(nil), cfg.Questions...),
+		QuestionStyles:                cfg.QuestionStyles,
+		CarryQuestions:                cfg.CarryQuestions,
+		Editors:                       cfg.Editors,
 		ShowHints:                     cfg.HintsEnabled(),
 		ShowHintsCustom:               cfg.ShowHints != nil,
 		AutoInsert:                    cfg.AutoInsertEnabled(),
@@ -80,6 +135,38 @@ func newConfigValues(cfg app.Config) configValues {
 		ConfirmDeleteCustom:           cfg.ConfirmDelete != nil,
 		ConfirmEscapeWithText:         cfg.ConfirmEscapeWithTextEnabled(),
 		ConfirmEscapeWithTextCustom:   cfg.ConfirmEscapeWithText != nil,
+		DefaultViewInterval:           cfg.ViewInterval(),
+		DefaultViewIntervalSet:        cfg.DefaultViewInterval != "",
+		DedupeEntries:                 cfg.DedupeEntriesEnabled(),
+		DedupeEntriesCustom:           cfg.DedupeEntries != nil,
+		EntrySoftLimit:                cfg.EntrySoftLimit(),
+		EntrySoftLimitSet:             cfg.EntrySoftLimitChars != nil,
+		SkipPlaceholder:               cfg.SkipAnswerPlaceholder(),
+		SkipPlaceholderSet:            cfg.SkipPlaceholder != "",
+		compactStorage:                cfg.CompactStorageEnabled(),
+		compactStorageCustom:          cfg.CompactStorage != nil,
+		displayTimezone:               cfg.DisplayZone(),
+		displayTimezoneSet:            cfg.DisplayTimezone != "",
+		shuffleQuestions:              cfg.ShuffleQuestionsEnabled(),
+		shuffleQuestionsCustom:        cfg.ShuffleQuestions != nil,
+		statusPosition:                cfg.StatusPlacement(),
+		statusPositionSet:             cfg.StatusPosition != "",
+		noEntriesMessage:              cfg.NoEntriesText(),
+		noEntriesMessageSet:           cfg.NoEntriesMessage != "",
+		WarnResponseLen:               cfg.WarnResponseLength(),
+		WarnResponseLenSet:            cfg.WarnResponseLen != nil,
+		timestampPrecision:            cfg.TimestampResolution(),
+		timestampPrecisionSet:         cfg.TimestampPrecision != "",
+		locale:                        cfg.LocaleName(),
+		localeSet:                     cfg.Locale != "",
+		silentEmpty:                   cfg.SilentEmptyEnabled(),
+		silentEmptyCustom:             cfg.SilentEmpty != nil,
+		softDelete:                    cfg.SoftDeleteEnabled(),
+		softDeleteCustom:              cfg.SoftDelete != nil,
+		allowFutureEntries:            cfg.FutureEntriesAllowed(),
+		allowFutureEntriesCustom:      cfg.AllowFutureEntries != nil,
+		orderedAnswers:                cfg.OrderedAnswersEnabled(),
+		orderedAnswersCustom:          cfg.OrderedAnswers != nil,
 	}
 	resolved := int(cfg.StatusMessageDuration() / time.Millisecond)
 	if resolved <= 0 {
@@ -135,11 +222,48 @@ func (v configValues) equal(other configValues) bool {
 		v.StatusDuration == other.StatusDuration &&
 		v.StatusDurationSet == other.StatusDurationSet &&
 		v.EscapeConfirmTimeout == other.EscapeConfirmTimeout &&
-		v.EscapeConfirmTimeoutSet == other.EscapeConfirmTimeoutSet
+		v.EscapeConfirmTimeoutSet == other.EscapeConfirmTimeoutSet &&
+		v.DefaultViewInterval == other.DefaultViewInterval &&
+		v.DefaultViewIntervalSet == other.DefaultViewIntervalSet &&
+		v.DedupeEntries == other.DedupeEntries &&
+		v.DedupeEntriesCustom == other.DedupeEntriesCustom &&
+		v.EntrySoftLimit == other.EntrySoftLimit &&
+		v.EntrySoftLimitSet == other.EntrySoftLimitSet &&
+		v.SkipPlaceholder == other.SkipPlaceholder &&
+		v.SkipPlaceholderSet == other.SkipPlaceholderSet &&
+		v.compactStorage == other.compactStorage &&
+		v.compactStorageCustom == other.compactStorageCustom &&
+		v.displayTimezone == other.displayTimezone &&
+		v.displayTimezoneSet == other.displayTimezoneSet &&
+		v.shuffleQuestions == other.shuffleQuestions &&
+		v.shuffleQuestionsCustom == other.shuffleQuestionsCustom &&
+		v.statusPosition == other.statusPosition &&
+		v.statusPositionSet == other.statusPositionSet &&
+		v.noEntriesMessage == other.noEntriesMessage &&
+		v.noEntriesMessageSet == other.noEntriesMessageSet &&
+		v.WarnResponseLen == other.WarnResponseLen &&
+		v.WarnResponseLenSet == other.WarnResponseLenSet &&
+		v.timestampPrecision == other.timestampPrecision &&
+		v.timestampPrecisionSet == other.timestampPrecisionSet &&
+		v.locale == other.locale &&
+		v.localeSet == other.localeSet &&
+		v.silentEmpty == other.silentEmpty &&
+		v.silentEmptyCustom == other.silentEmptyCustom &&
+		v.softDelete == other.softDelete &&
+		v.softDeleteCustom == other.softDeleteCustom &&
+		v.allowFutureEntries == other.allowFutureEntries &&
+		v.allowFutureEntriesCustom == other.allowFutureEntriesCustom &&
+		v.orderedAnswers == other.orderedAnswers &&
+		v.orderedAnswersCustom == other.orderedAnswersCustom
 }
 
 func (v configValues) toConfig() app.Config {
-	cfg := app.Config{Questions: append([]string(nil), v.Questions...)}
+	cfg := app.Config{
+		Questions:      append([]string(nil), v.Questions...),
+		QuestionStyles: v.QuestionStyles,
+		CarryQuestions: v.CarryQuestions,
+		Editors:        v.Editors,
+	}
 	if v.ShowHintsCustom {
 		cfg.ShowHints = boolPtr(v.ShowHints)
 	}
@@ -167,6 +291,54 @@ func (v configValues) toConfig() app.Config {
 	if v.EscapeConfirmTimeoutSet {
 		cfg.EscapeConfirmTimeoutMs = intPtr(v.EscapeConfirmTimeout)
 	}
+	if v.DefaultViewIntervalSet {
+		cfg.DefaultViewInterval = v.DefaultViewInterval
+	}
+	if v.DedupeEntriesCustom {
+		cfg.DedupeEntries = boolPtr(v.DedupeEntries)
+	}
+	if v.EntrySoftLimitSet {
+		cfg.EntrySoftLimitChars = intPtr(v.EntrySoftLimit)
+	}
+	if v.SkipPlaceholderSet {
+		cfg.SkipPlaceholder = v.SkipPlaceholder
+	}
+	if v.compactStorageCustom {
+		cfg.CompactStorage = boolPtr(v.compactStorage)
+	}
+	if v.displayTimezoneSet {
+		cfg.DisplayTimezone = v.displayTimezone
+	}
+	if v.shuffleQuestionsCustom {
+		cfg.ShuffleQuestions = boolPtr(v.shuffleQuestions)
+	}
+	if v.statusPositionSet {
+		cfg.StatusPosition = v.statusPosition
+	}
+	if v.noEntriesMessageSet {
+		cfg.NoEntriesMessage = v.noEntriesMessage
+	}
+	if v.WarnResponseLenSet {
+		cfg.WarnResponseLen = intPtr(v.WarnResponseLen)
+	}
+	if v.timestampPrecisionSet {
+		cfg.TimestampPrecision = v.timestampPrecision
+	}
+	if v.localeSet {
+		cfg.Locale = v.locale
+	}
+	if v.silentEmptyCustom {
+		cfg.SilentEmpty = boolPtr(v.silentEmpty)
+	}
+	if v.softDeleteCustom {
+		cfg.SoftDelete = boolPtr(v.softDelete)
+	}
+	if v.allowFutureEntriesCustom {
+		cfg.AllowFutureEntries = boolPtr(v.allowFutureEntries)
+	}
+	if v.orderedAnswersCustom {
+		cfg.OrderedAnswers = boolPtr(v.orderedAnswers)
+	}
 	return cfg
 }
 
@@ -202,6 +374,10 @@ type configModel struct {
 	err    error
 	width  int
 	height int
+
+	// usage holds the number of stored answers per question, counted once
+	// when the editor opens.
+	usage map[string]int
 }
 
 func newConfigModel(cfg app.Config) *configModel {
@@ -216,6 +392,9 @@ func newConfigModel(cfg app.Config) *configModel {
 		statusTimeout: 2 * time.Second,
 		editingIndex:  -1,
 	}
+	if usage, err := app.CountAnswersByQuestion(); err == nil {
+		model.usage = usage
+	}
 	model.rebuildRows()
 	return model
 }
@@ -341,6 +520,8 @@ func (m *configModel) activateSelection() tea.Cmd {
 		m.toggleBool(row.field)
 	case cfgRowInt:
 		m.startIntEdit(row.field)
+	case cfgRowString:
+		m.startStringEdit(row.field)
 	}
 	return nil
 }
@@ -357,6 +538,8 @@ func (m *configModel) deleteOrDefaultSelection() {
 		m.resetBoolField(row.field)
 	case cfgRowInt:
 		m.resetIntField(row.field)
+	case cfgRowString:
+		m.resetStringField(row.field)
 	}
 }
 
@@ -395,6 +578,27 @@ func (m *configModel) resetBoolField(field configField) {
 	case cfgFieldConfirmEscapeWithText:
 		m.values.ConfirmEscapeWithText = defaultCfg.ConfirmEscapeWithTextEnabled()
 		m.values.ConfirmEscapeWithTextCustom = false
+	case cfgFieldDedupeEntries:
+		m.values.DedupeEntries = defaultCfg.DedupeEntriesEnabled()
+		m.values.DedupeEntriesCustom = false
+	case cfgFieldCompactStorage:
+		m.values.compactStorage = defaultCfg.CompactStorageEnabled()
+		m.values.compactStorageCustom = false
+	case cfgFieldShuffleQuestions:
+		m.values.shuffleQuestions = defaultCfg.ShuffleQuestionsEnabled()
+		m.values.shuffleQuestionsCustom = false
+	case cfgFieldSilentEmpty:
+		m.values.silentEmpty = defaultCfg.SilentEmptyEnabled()
+		m.values.silentEmptyCustom = false
+	case cfgFieldSoftDelete:
+		m.values.softDelete = defaultCfg.SoftDeleteEnabled()
+		m.values.softDeleteCustom = false
+	case cfgFieldAllowFutureEntries:
+		m.values.allowFutureEntries = defaultCfg.FutureEntriesAllowed()
+		m.values.allowFutureEntriesCustom = false
+	case cfgFieldOrderedAnswers:
+		m.values.orderedAnswers = defaultCfg.OrderedAnswersEnabled()
+		m.values.orderedAnswersCustom = false
 	default:
 		changed = false
 	}
@@ -414,6 +618,43 @@ func (m *configModel) resetIntField(field configField) {
 	case cfgFieldEscapeConfirmTimeout:
 		m.values.EscapeConfirmTimeout = int(defaultCfg.EscapeConfirmTimeout() / time.Millisecond)
 		m.values.EscapeConfirmTimeoutSet = false
+	case cfgFieldEntrySoftLimit:
+		m.values.EntrySoftLimit = defaultCfg.EntrySoftLimit()
+		m.values.EntrySoftLimitSet = false
+	case cfgFieldWarnResponseLen:
+		m.values.WarnResponseLen = m.values.EntrySoftLimit
+		m.values.WarnResponseLenSet = false
+	default:
+		return
+	}
+	m.markDirty()
+	m.setStatus("Option reset to default.")
+}
+
+func (m *configModel) resetStringField(field configField) {
+	defaultCfg := app.Config{}
+	switch field {
+	case cfgFieldDefaultViewInterval:
+		m.values.DefaultViewInterval = defaultCfg.ViewInterval()
+		m.values.DefaultViewIntervalSet = false
+	case cfgFieldSkipPlaceholder:
+		m.values.SkipPlaceholder = defaultCfg.SkipAnswerPlaceholder()
+		m.values.SkipPlaceholderSet = false
+	case cfgFieldDisplayTimezone:
+		m.values.displayTimezone = defaultCfg.DisplayZone()
+		m.values.displayTimezoneSet = false
+	case cfgFieldStatusPosition:
+		m.values.statusPosition = defaultCfg.StatusPlacement()
+		m.values.statusPositionSet = false
+	case cfgFieldNoEntriesMessage:
+		m.values.noEntriesMessage = defaultCfg.NoEntriesText()
+		m.values.noEntriesMessageSet = false
+	case cfgFieldTimestampPrecision:
+		m.values.timestampPrecision = defaultCfg.TimestampResolution()
+		m.values.timestampPrecisionSet = false
+	case cfgFieldLocale:
+		m.values.locale = defaultCfg.LocaleName()
+		m.values.localeSet = false
 	default:
 		return
 	}
@@ -460,6 +701,66 @@ func (m *configModel) startIntEdit(field configField) {
 		if m.values.EscapeConfirmTimeoutSet {
 			value = strconv.Itoa(m.values.EscapeConfirmTimeout)
 		}
+	case cfgFieldEntrySoftLimit:
+		placeholder = "Entry soft limit (characters)"
+		if m.values.EntrySoftLimitSet {
+			value = strconv.Itoa(m.values.EntrySoftLimit)
+		}
+	case cfgFieldWarnResponseLen:
+		placeholder = "Long answer warning (chars)"
+		if m.values.WarnResponseLenSet {
+			value = strconv.Itoa(m.values.WarnResponseLen)
+		}
+	}
+	m.input.Placeholder = placeholder
+	m.input.SetValue(value)
+	m.input.CursorEnd()
+	m.input.Focus()
+}
+
+func (m *configModel) startStringEdit(field configField) {
+	m.editing = true
+	m.editingKind = cfgRowString
+	m.editingField = field
+	m.editOriginal = ""
+	placeholder := ""
+	value := ""
+	switch field {
+	case cfgFieldDefaultViewInterval:
+		placeholder = "Default view interval (e.g. last 7 days)"
+		if m.values.DefaultViewIntervalSet {
+			value = m.values.DefaultViewInterval
+		}
+	case cfgFieldSkipPlaceholder:
+		placeholder = "Answer recorded for skipped prompts"
+		if m.values.SkipPlaceholderSet {
+			value = m.values.SkipPlaceholder
+		}
+	case cfgFieldDisplayTimezone:
+		placeholder = "local, utc, or an IANA zone like Europe/Berlin"
+		if m.values.displayTimezoneSet {
+			value = m.values.displayTimezone
+		}
+	case cfgFieldStatusPosition:
+		placeholder = "inline or bottom"
+		if m.values.statusPositionSet {
+			value = m.values.statusPosition
+		}
+	case cfgFieldNoEntriesMessage:
+		placeholder = "No entries found for %s."
+		if m.values.noEntriesMessageSet {
+			value = m.values.noEntriesMessage
+		}
+	case cfgFieldTimestampPrecision:
+		placeholder = "second or minute"
+		if m.values.timestampPrecisionSet {
+			value = m.values.timestampPrecision
+		}
+	case cfgFieldLocale:
+		placeholder = "en, de, es or fr"
+		if m.values.localeSet {
+			value = m.values.locale
+		}
 	}
 	m.input.Placeholder = placeholder
 	m.input.SetValue(value)
@@ -473,6 +774,8 @@ func (m *configModel) commitEdit() {
 		m.commitQuestionEdit()
 	case cfgRowInt:
 		m.commitIntEdit()
+	case cfgRowString:
+		m.commitStringEdit()
 	}
 }
 
@@ -507,14 +810,21 @@ func (m *configModel) commitIntEdit() {
 		case cfgFieldEscapeConfirmTimeout:
 			m.values.EscapeConfirmTimeoutSet = false
 			m.values.EscapeConfirmTimeout = int(defaultCfg.EscapeConfirmTimeout() / time.Millisecond)
+		case cfgFieldEntrySoftLimit:
+			m.values.EntrySoftLimitSet = false
+			m.values.EntrySoftLimit = defaultCfg.EntrySoftLimit()
+		case cfgFieldWarnResponseLen:
+			m.values.WarnResponseLenSet = false
+			m.values.WarnResponseLen = m.values.EntrySoftLimit
 		default:
-			m.setStatus("Enter a positive number of milliseconds.")
+			m.setStatus("Enter a positive number.")
 			return
 		}
 	} else {
 		val, err := strconv.Atoi(raw)
-		if err != nil || val <= 0 {
-			m.setStatus("Enter a positive number of milliseconds.")
+		// 0 turns the long answer warning off, even with a soft limit set.
+		if err != nil || val < 0 || (val == 0 && field != cfgFieldWarnResponseLen) {
+			m.setStatus("Enter a positive number.")
 			return
 		}
 		switch field {
@@ -524,8 +834,14 @@ func (m *configModel) commitIntEdit() {
 		case cfgFieldEscapeConfirmTimeout:
 			m.values.EscapeConfirmTimeout = val
 			m.values.EscapeConfirmTimeoutSet = true
+		case cfgFieldEntrySoftLimit:
+			m.values.EntrySoftLimit = val
+			m.values.EntrySoftLimitSet = true
+		case cfgFieldWarnResponseLen:
+			m.values.WarnResponseLen = val
+			m.values.WarnResponseLenSet = true
 		default:
-			m.setStatus("Enter a positive number of milliseconds.")
+			m.setStatus("Enter a positive number.")
 			return
 		}
 	}
@@ -536,6 +852,91 @@ func (m *configModel) commitIntEdit() {
 	m.markDirty()
 }
 
+func (m *configModel) commitStringEdit() {
+	raw := strings.TrimSpace(m.input.Value())
+	defaultCfg := app.Config{}
+	switch m.editingField {
+	case cfgFieldDefaultViewInterval:
+		if raw == "" {
+			m.values.DefaultViewInterval = defaultCfg.ViewInterval()
+			m.values.DefaultViewIntervalSet = false
+			break
+		}
+		if _, _, err := app.ParseInterval(raw); err != nil {
+			m.setStatus(fmt.Sprintf("Invalid interval: %v", err))
+			return
+		}
+		m.values.DefaultViewInterval = raw
+		m.values.DefaultViewIntervalSet = true
+	case cfgFieldSkipPlaceholder:
+		if raw == "" {
+			m.values.SkipPlaceholder = defaultCfg.SkipAnswerPlaceholder()
+			m.values.SkipPlaceholderSet = false
+			break
+		}
+		m.values.SkipPlaceholder = raw
+		m.values.SkipPlaceholderSet = true
+	case cfgFieldDisplayTimezone:
+		if raw == "" {
+			m.values.displayTimezone = defaultCfg.DisplayZone()
+			m.values.displayTimezoneSet = false
+			break
+		}
+		m.values.displayTimezone = raw
+		m.values.displayTimezoneSet = true
+	case cfgFieldStatusPosition:
+		if raw == "" {
+			m.values.statusPosition = defaultCfg.StatusPlacement()
+			m.values.statusPositionSet = false
+			break
+		}
+		raw = strings.ToLower(raw)
+		if raw != app.StatusPositionInline && raw != app.StatusPositionBottom {
+			m.setStatus("Status position must be inline or bottom.")
+			return
+		}
+		m.values.statusPosition = raw
+		m.values.statusPositionSet = true
+	case cfgFieldNoEntriesMessage:
+		if raw == "" {
+			m.values.noEntriesMessage = defaultCfg.NoEntriesText()
+			m.values.noEntriesMessageSet = false
+			break
+		}
+		m.values.noEntriesMessage = raw
+		m.values.noEntriesMessageSet = true
+	case cfgFieldTimestampPrecision:
+		if raw == "" {
+			m.values.timestampPrecision = defaultCfg.TimestampResolution()
+			m.values.timestampPrecisionSet = false
+			break
+		}
+		raw = strings.ToLower(raw)
+		if raw != app.TimestampPrecisionSecond && raw != app.TimestampPrecisionMinute {
+			m.setStatus("Timestamp precision must be second or minute.")
+			return
+		}
+		m.values.timestampPrecision = raw
+		m.values.timestampPrecisionSet = true
+	case cfgFieldLocale:
+		if raw == "" {
+			m.values.locale = defaultCfg.LocaleName()
+			m.values.localeSet = false
+			break
+		}
+		if err := app.ValidateLocale(raw); err != nil {
+			m.setStatus(err.Error())
+			return
+		}
+		m.values.locale = raw
+		m.values.localeSet = true
+	default:
+		return
+	}
+	m.finishEditing()
+	m.markDirty()
+}
+
 func (m *configModel) finishEditing() {
 	m.editing = false
 	m.editingIndex = -1
@@ -575,6 +976,27 @@ func (m *configModel) toggleBool(field configField) {
 	case cfgFieldConfirmEscapeWithText:
 		m.values.ConfirmEscapeWithText = !m.values.ConfirmEscapeWithText
 		m.values.ConfirmEscapeWithTextCustom = true
+	case cfgFieldDedupeEntries:
+		m.values.DedupeEntries = !m.values.DedupeEntries
+		m.values.DedupeEntriesCustom = true
+	case cfgFieldCompactStorage:
+		m.values.compactStorage = !m.values.compactStorage
+		m.values.compactStorageCustom = true
+	case cfgFieldShuffleQuestions:
+		m.values.shuffleQuestions = !m.values.shuffleQuestions
+		m.values.shuffleQuestionsCustom = true
+	case cfgFieldSilentEmpty:
+		m.values.silentEmpty = !m.values.silentEmpty
+		m.values.silentEmptyCustom = true
+	case cfgFieldSoftDelete:
+		m.values.softDelete = !m.values.softDelete
+		m.values.softDeleteCustom = true
+	case cfgFieldAllowFutureEntries:
+		m.values.allowFutureEntries = !m.values.allowFutureEntries
+		m.values.allowFutureEntriesCustom = true
+	case cfgFieldOrderedAnswers:
+		m.values.orderedAnswers = !m.values.orderedAnswers
+		m.values.orderedAnswersCustom = true
 	}
 	m.markDirty()
 }
@@ -675,6 +1097,22 @@ func (m *configModel) rebuildRows() {
 	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldConfirmEscapeWithText})
 	rows = append(rows, configRow{kind: cfgRowInt, field: cfgFieldStatusDuration})
 	rows = append(rows, configRow{kind: cfgRowInt, field: cfgFieldEscapeConfirmTimeout})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldDefaultViewInterval})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldDedupeEntries})
+	rows = append(rows, configRow{kind: cfgRowInt, field: cfgFieldEntrySoftLimit})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldSkipPlaceholder})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldCompactStorage})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldDisplayTimezone})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldShuffleQuestions})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldStatusPosition})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldNoEntriesMessage})
+	rows = append(rows, configRow{kind: cfgRowInt, field: cfgFieldWarnResponseLen})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldTimestampPrecision})
+	rows = append(rows, configRow{kind: cfgRowString, field: cfgFieldLocale})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldSilentEmpty})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldSoftDelete})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldAllowFutureEntries})
+	rows = append(rows, configRow{kind: cfgRowBool, field: cfgFieldOrderedAnswers})
 	m.rows = rows
 	if m.selected >= len(rows) {
 		m.selected = len(rows) - 1
@@ -684,6 +1122,13 @@ func (m *configModel) rebuildRows() {
 	}
 }
 
+func (m *configModel) usageBadge(question string) string {
+	if m.usage == nil || question == "" {
+		return ""
+	}
+	return statusStyle.Render(fmt.Sprintf(" (%d used)", m.usage[question]))
+}
+
 func (m *configModel) View() string {
 	var b strings.Builder
 	b.WriteString("Configuration")
@@ -707,7 +1152,7 @@ func (m *configModel) View() string {
 				if label == "" {
 					label = "(empty)"
 				}
-				b.WriteString(fmt.Sprintf("%s  [%d] %s\n", marker, row.index+1, label))
+				b.WriteString(fmt.Sprintf("%s  [%d] %s%s\n", marker, row.index+1, label, m.usageBadge(m.values.Questions[row.index])))
 			} else {
 				b.WriteString(fmt.Sprintf("%s  [+] Add question\n", marker))
 			}
@@ -716,7 +1161,7 @@ func (m *configModel) View() string {
 
 	b.WriteString("\nOptions:\n")
 	for idx, row := range m.rows {
-		if row.kind == cfgRowBool || row.kind == cfgRowInt {
+		if row.kind == cfgRowBool || row.kind == cfgRowInt || row.kind == cfgRowString {
 			marker := " "
 			if idx == m.selected {
 				marker = ">"
@@ -748,6 +1193,38 @@ func (m *configModel) View() string {
 					timeLabel += " (default)"
 				}
 				b.WriteString(fmt.Sprintf("%s  Escape confirm timeout: %s\n", marker, timeLabel))
+			case cfgFieldDefaultViewInterval:
+				b.WriteString(fmt.Sprintf("%s  Default view interval: %s\n", marker, stringLabel(m.values.DefaultViewInterval, !m.values.DefaultViewIntervalSet)))
+			case cfgFieldDedupeEntries:
+				b.WriteString(fmt.Sprintf("%s  Skip duplicate entries: %s\n", marker, boolLabel(m.values.DedupeEntries, !m.values.DedupeEntriesCustom)))
+			case cfgFieldEntrySoftLimit:
+				b.WriteString(fmt.Sprintf("%s  Entry soft limit: %s\n", marker, intLabel(m.values.EntrySoftLimit, !m.values.EntrySoftLimitSet)))
+			case cfgFieldSkipPlaceholder:
+				b.WriteString(fmt.Sprintf("%s  Skip placeholder: %s\n", marker, stringLabel(m.values.SkipPlaceholder, !m.values.SkipPlaceholderSet)))
+			case cfgFieldCompactStorage:
+				b.WriteString(fmt.Sprintf("%s  Compact storage: %s\n", marker, boolLabel(m.values.compactStorage, !m.values.compactStorageCustom)))
+			case cfgFieldDisplayTimezone:
+				b.WriteString(fmt.Sprintf("%s  Display timezone: %s\n", marker, stringLabel(m.values.displayTimezone, !m.values.displayTimezoneSet)))
+			case cfgFieldShuffleQuestions:
+				b.WriteString(fmt.Sprintf("%s  Shuffle prompt order: %s\n", marker, boolLabel(m.values.shuffleQuestions, !m.values.shuffleQuestionsCustom)))
+			case cfgFieldStatusPosition:
+				b.WriteString(fmt.Sprintf("%s  Status position: %s\n", marker, stringLabel(m.values.statusPosition, !m.values.statusPositionSet)))
+			case cfgFieldNoEntriesMessage:
+				b.WriteString(fmt.Sprintf("%s  Empty result message: %s\n", marker, stringLabel(m.values.noEntriesMessage, !m.values.noEntriesMessageSet)))
+			case cfgFieldWarnResponseLen:
+				b.WriteString(fmt.Sprintf("%s  Long answer warning (chars): %s\n", marker, intLabel(m.values.WarnResponseLen, !m.values.WarnResponseLenSet)))
+			case cfgFieldTimestampPrecision:
+				b.WriteString(fmt.Sprintf("%s  Timestamp precision: %s\n", marker, stringLabel(m.values.timestampPrecision, !m.values.timestampPrecisionSet)))
+			case cfgFieldLocale:
+				b.WriteString(fmt.Sprintf("%s  Locale: %s\n", marker, stringLabel(m.values.locale, !m.values.localeSet)))
+			case cfgFieldSilentEmpty:
+				b.WriteString(fmt.Sprintf("%s  Silent when nothing is recorded: %s\n", marker, boolLabel(m.values.silentEmpty, !m.values.silentEmptyCustom)))
+			case cfgFieldSoftDelete:
+				b.WriteString(fmt.Sprintf("%s  Keep deleted entries in trash: %s\n", marker, boolLabel(m.values.softDelete, !m.values.softDeleteCustom)))
+			case cfgFieldAllowFutureEntries:
+				b.WriteString(fmt.Sprintf("%s  Allow entries on future days: %s\n", marker, boolLabel(m.values.allowFutureEntries, !m.values.allowFutureEntriesCustom)))
+			case cfgFieldOrderedAnswers:
+				b.WriteString(fmt.Sprintf("%s  Store answers as an ordered list: %s\n", marker, boolLabel(m.values.orderedAnswers, !m.values.orderedAnswersCustom)))
 			}
 		}
 	}
@@ -783,6 +1260,25 @@ func boolLabel(value bool, isDefault bool) string {
 	return label
 }
 
+func intLabel(value int, isDefault bool) string {
+	label := "off"
+	if value > 0 {
+		label = strconv.Itoa(value)
+	}
+	if isDefault {
+		label += " (default)"
+	}
+	return label
+}
+
+func stringLabel(value string, isDefault bool) string {
+	label := value
+	if isDefault {
+		label += " (default)"
+	}
+	return label
+}
+
 func intPtr(v int) *int {
 	b := v
 	return &b
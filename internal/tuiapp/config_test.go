@@ -0,0 +1,21 @@
+package tuiapp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/almahoozi/wlog/internal/app"
+)
+
+func TestConfigEditorUsageBadges(t *testing.T) {
+	cfg := app.Config{Questions: []string{"Q1", "Q2"}}
+	newTestModel(t, cfg, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("a", "b", "c")}})
+	m := newConfigModel(cfg)
+	if m.usage["Q1"] != 3 || m.usage["Q2"] != 0 {
+		t.Fatalf("usage = %v", m.usage)
+	}
+	view := m.View()
+	if !strings.Contains(view, "Q1 (3 used)") || !strings.Contains(view, "Q2 (0 used)") {
+		t.Fatalf("usage badges missing:\n%s", view)
+	}
+}
@@ -4,11 +4,28 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"runtime"
 	"strings"
+	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+var (
+	editorOverride  string
+	editorFallbacks []string
+)
+
+// defaultEditor is tried when neither the environment nor the config's
+// editors list names one.
+const defaultEditor = "vim"
+
+// SetEditor overrides the $VISUAL/$EDITOR resolution for editors launched by
+// the TUI. An empty value restores the environment-based default.
+func SetEditor(editor string) {
+	editorOverride = strings.TrimSpace(editor)
+}
+
 type editorResultMsg struct {
 	question   string
 	entryIndex int
@@ -62,21 +79,11 @@ func editEntriesCmd(question string, lines []string, entryIndex int) tea.Cmd {
 }
 
 func buildEditorCommand(path string) (*exec.Cmd, error) {
-	editor := os.Getenv("VISUAL")
-	if editor == "" {
-		editor = os.Getenv("EDITOR")
-	}
-	if editor == "" {
-		editor = "vim"
-	}
-	parts := strings.Fields(editor)
-	if len(parts) == 0 {
-		parts = []string{editor}
+	parts, err := resolveEditor(editorCandidates(), exec.LookPath)
+	if err != nil {
+		return nil, err
 	}
 	parts = append(parts, path)
-	if _, err := exec.LookPath(parts[0]); err != nil {
-		return nil, fmt.Errorf("unable to launch editor %q: %w", parts[0], err)
-	}
 	cmd := exec.Command(parts[0], parts[1:]...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
@@ -84,6 +91,100 @@ func buildEditorCommand(path string) (*exec.Cmd, error) {
 	return cmd, nil
 }
 
+// editorCandidates lists editor commands in the order they are tried: the
+// --editor flag, $VISUAL, $EDITOR, then the config's editors list, or vim when
+// that list is empty.
+func editorCandidates() []string {
+	var candidates []string
+	for _, editor := range []string{editorOverride, os.Getenv("VISUAL"), os.Getenv("EDITOR")} {
+		if editor != "" {
+			candidates = append(candidates, editor)
+		}
+	}
+	if len(editorFallbacks) == 0 {
+		return append(candidates, defaultEditor)
+	}
+	return append(candidates, editorFallbacks...)
+}
+
+// resolveEditor returns the words of the first candidate whose program
+// lookPath can find, or an error naming every program it tried.
+func resolveEditor(candidates []string, lookPath func(string) (string, error)) ([]string, error) {
+	var tried []string
+	for _, editor := range candidates {
+		parts, err := splitCommandLine(editor)
+		if err != nil {
+			parts = strings.Fields(editor)
+		}
+		if len(parts) == 0 {
+			parts = []string{editor}
+		}
+		if _, err := lookPath(parts[0]); err == nil {
+			return parts, nil
+		}
+		tried = append(tried, parts[0])
+	}
+	return nil, fmt.Errorf("unable to launch an editor, none of these were found: %s", strings.Join(tried, ", "))
+}
+
+// splitCommandLine splits a command string into words the way a POSIX shell
+// would: single quotes are literal, double quotes allow backslash escapes of
+// quote, backslash, dollar and backtick, and unquoted backslashes escape the
+// next character except on Windows where they are path separators.
+func splitCommandLine(value string) ([]string, error) {
+	var words []string
+	var current strings.Builder
+	inWord := false
+	runes := []rune(value)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			inWord = true
+			end := i + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated single quote in %q", value)
+			}
+			current.WriteString(string(runes[i+1 : end]))
+			i = end
+		case r == '"':
+			inWord = true
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune("\"\\$`", runes[i+1]) {
+					i++
+				}
+				current.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated double quote in %q", value)
+			}
+		case r == '\\' && runtime.GOOS != "windows":
+			inWord = true
+			if i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+			}
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		default:
+			inWord = true
+			current.WriteRune(r)
+		}
+	}
+	if inWord {
+		words = append(words, current.String())
+	}
+	return words, nil
+}
+
 func normalizeEditorContent(value string) string {
 	value = strings.ReplaceAll(value, "\r\n", "\n")
 	value = strings.TrimRight(value, "\n")
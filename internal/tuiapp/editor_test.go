@@ -0,0 +1,155 @@
+package tuiapp
+
+import (
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// findAll is a lookPath that finds every program.
+func findAll(name string) (string, error) {
+	return "/usr/bin/" + name, nil
+}
+
+func TestEditorOverridePrecedence(t *testing.T) {
+	t.Cleanup(func() { SetEditor("") })
+	tests := []struct {
+		name     string
+		override string
+		visual   string
+		editor   string
+		want     []string
+	}{
+		{"override wins", "code -w", "nano", "vi", []string{"code", "-w"}},
+		{"visual before editor", "", "nano", "vi", []string{"nano"}},
+		{"editor", "", "", "vi", []string{"vi"}},
+		{"default", "", "", "", []string{defaultEditor}},
+		{"blank override ignored", "   ", "nano", "", []string{"nano"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VISUAL", tt.visual)
+			t.Setenv("EDITOR", tt.editor)
+			SetEditor(tt.override)
+			got, err := resolveEditor(editorCandidates(), findAll)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("editor = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildEditorCommandUsesOverride(t *testing.T) {
+	t.Cleanup(func() { SetEditor("") })
+	t.Setenv("VISUAL", "nano")
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no sh on PATH")
+	}
+	SetEditor(sh + " -c true")
+	cmd, err := buildEditorCommand("/tmp/entry.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{sh, "-c", "true", "/tmp/entry.txt"}; !reflect.DeepEqual(cmd.Args, want) {
+		t.Fatalf("command = %q, want %q", cmd.Args, want)
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"vim", []string{"vim"}},
+		{"  code   -w ", []string{"code", "-w"}},
+		{`"/Applications/My Editor" --wait`, []string{"/Applications/My Editor", "--wait"}},
+		{`'/opt/my editor/bin/ed' --title 'Daily log'`, []string{"/opt/my editor/bin/ed", "--title", "Daily log"}},
+		{`emacs --eval "(setq x \"y\")"`, []string{"emacs", "--eval", `(setq x "y")`}},
+		{`subl --arg=""`, []string{"subl", "--arg="}},
+		{`ed ''`, []string{"ed", ""}},
+	}
+	for _, tt := range tests {
+		got, err := splitCommandLine(tt.value)
+		if err != nil {
+			t.Errorf("splitCommandLine(%q): %v", tt.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitCommandLine(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+	for _, value := range []string{`"unterminated`, `it's`} {
+		if _, err := splitCommandLine(value); err == nil {
+			t.Errorf("splitCommandLine(%q): expected an error", value)
+		}
+	}
+}
+
+func TestResolveEditorQuotedPath(t *testing.T) {
+	var looked []string
+	lookPath := func(name string) (string, error) {
+		looked = append(looked, name)
+		return name, nil
+	}
+	got, err := resolveEditor([]string{`"/Applications/My Editor" --wait`}, lookPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"/Applications/My Editor", "--wait"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("editor = %q, want %q", got, want)
+	}
+	if !reflect.DeepEqual(looked, []string{"/Applications/My Editor"}) {
+		t.Fatalf("looked up %q", looked)
+	}
+
+	// A string that cannot be parsed falls back to splitting on spaces.
+	got, err = resolveEditor([]string{`vim "-u`}, findAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"vim", `"-u`}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("editor = %q, want %q", got, want)
+	}
+}
+
+func TestEditorFallbackChain(t *testing.T) {
+	t.Cleanup(func() { editorFallbacks = nil })
+	editorFallbacks = []string{"nvim", "vim", "nano"}
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	if got := editorCandidates(); !reflect.DeepEqual(got, []string{"nvim", "vim", "nano"}) {
+		t.Fatalf("candidates = %q", got)
+	}
+	t.Setenv("EDITOR", "emacs")
+	if got := editorCandidates(); !reflect.DeepEqual(got, []string{"emacs", "nvim", "vim", "nano"}) {
+		t.Fatalf("env editor does not come first: %q", got)
+	}
+
+	installed := map[string]bool{"vim": true, "nano": true}
+	var looked []string
+	lookPath := func(name string) (string, error) {
+		looked = append(looked, name)
+		if installed[name] {
+			return "/usr/bin/" + name, nil
+		}
+		return "", exec.ErrNotFound
+	}
+	got, err := resolveEditor(editorCandidates(), lookPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, []string{"vim"}) || !reflect.DeepEqual(looked, []string{"emacs", "nvim", "vim"}) {
+		t.Fatalf("resolved %q after looking up %q", got, looked)
+	}
+
+	installed = nil
+	_, err = resolveEditor(editorCandidates(), lookPath)
+	if err == nil || !strings.Contains(err.Error(), "emacs, nvim, vim, nano") {
+		t.Fatalf("error = %v, want every program tried", err)
+	}
+}
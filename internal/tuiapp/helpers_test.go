@@ -0,0 +1,61 @@
+package tuiapp
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/almahoozi/wlog/internal/app"
+)
+
+// testDay is a fixed past day, so entry-day checks never get in the way.
+var testDay = time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+
+// newTestModel points the data directory and config file at temporary paths,
+// writes log as the day file for testDay and opens a model on it.
+func newTestModel(t *testing.T, cfg app.Config, log app.DayLog) *model {
+	t.Helper()
+	root := t.TempDir()
+	t.Setenv("WLOG_DATA_DIR", filepath.Join(root, "data"))
+	t.Setenv("WLOG_CONFIG_FILE", filepath.Join(root, "config.json"))
+	if len(cfg.Questions) == 0 {
+		cfg.Questions = []string{"Q1", "Q2"}
+	}
+	if log.Answers != nil {
+		if err := app.SaveDayLog(testDay, log, cfg.Settings()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := newModel(cfg, Options{Date: testDay})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// reloadDay reads the day file for testDay back from disk.
+func reloadDay(t *testing.T) app.DayLog {
+	t.Helper()
+	log, err := app.LoadDayLog(testDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return log
+}
+
+func answers(responses ...string) []app.Answer {
+	out := make([]app.Answer, 0, len(responses))
+	for i, resp := range responses {
+		out = append(out, app.Answer{Time: testDay.Add(time.Duration(9+i) * time.Hour).Format(time.RFC3339), Response: resp})
+	}
+	return out
+}
+
+// typeText sends text to the model one rune at a time, as a user typing it.
+func typeText(m *model, text string) {
+	for _, r := range text {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
@@ -1,8 +1,8 @@
 package tuiapp
 
 import (
-	"fmt"
-	"os"
+	"errors"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
@@ -12,16 +12,33 @@ import (
 // Run launches the daily log TUI. It loads the config before starting and returns
 // any fatal error encountered while initializing or running the program.
 func Run() error {
+	return RunWithOptions(Options{})
+}
+
+// Options customizes how the daily log TUI starts.
+type Options struct {
+	// ReadOnly disables every key binding that would modify a day log.
+	ReadOnly bool
+	// Date is the day to open on. The zero value means today.
+	Date time.Time
+}
+
+// RunWithOptions is like Run but starts the TUI with the provided options.
+func RunWithOptions(opts Options) error {
 	cfg, err := app.LoadConfig()
+	if errors.Is(err, app.ErrConfig) {
+		return err
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "using default questions: %v\n", err)
+		app.Warnf("using default questions: %v\n", err)
 	}
-	return RunWithConfig(cfg)
+	return RunWithConfig(cfg, opts)
 }
 
-// RunWithConfig is like Run but uses a provided config instance.
-func RunWithConfig(cfg app.Config) error {
-	mdl, err := newModel(cfg)
+// RunWithConfig is like RunWithOptions but uses a provided config instance.
+func RunWithConfig(cfg app.Config, opts Options) error {
+	editorFallbacks = cfg.Editors
+	mdl, err := newModel(cfg, opts)
 	if err != nil {
 		return err
 	}
@@ -3,9 +3,11 @@ package tuiapp
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,8 +20,21 @@ var indexRunes = []rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'a', '
 
 const jkDisableThreshold = 20
 
+const statusBarMinHeight = 10
+
+const (
+	minTerminalWidth  = 40
+	minTerminalHeight = 6
+)
+
 var statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
 
+var highPriorityStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+var lowPriorityStyle = lipgloss.NewStyle().Faint(true)
+
+var dayBannerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+
 type viewMode int
 
 const (
@@ -74,21 +89,31 @@ type externalOpenResultMsg struct {
 type model struct {
 	cfgQuestions []string
 	config       app.Config
+	settings     app.Settings
 	day          time.Time
 	log          app.DayLog
 
+	// rangeStart and rangeEnd bound the days navigated to this session; the
+	// status bar reports the current day's position within them.
+	rangeStart time.Time
+	rangeEnd   time.Time
+
 	questions     []string
 	questionIndex map[string]int
 	rows          []listRow
 	selected      int
+	scrollOffset  int
 
+	readOnly             bool
 	listMode             bool
+	expandedQuestion     string
 	disableJKNav         bool
 	showHints            bool
 	autoInsert           bool
 	continueAfterInsert  bool
 	autoOpenIndex        bool
 	confirmDelete        bool
+	softDelete           bool
 	confirmEscape        bool
 	escapeConfirmTimeout time.Duration
 
@@ -99,11 +124,16 @@ type model struct {
 	confirmPrompt    string
 	showDeletePrompt bool
 
+	reloadConfirmActive bool
+	moodPromptActive    bool
+
 	escapeConfirmActive bool
 	escapeConfirmSeq    int
 	escapeConfirmTimer  tea.Cmd
 	escapeConfirmPrompt string
 
+	quitConfirmActive bool
+
 	status         string
 	statusSeq      int
 	statusTimeout  time.Duration
@@ -114,8 +144,11 @@ type model struct {
 	height int
 }
 
-func newModel(cfg app.Config) (*model, error) {
+func newModel(cfg app.Config, opts Options) (*model, error) {
 	day := app.DayFloor(time.Now())
+	if !opts.Date.IsZero() {
+		day = app.DayFloor(opts.Date)
+	}
 	log, err := app.LoadDayLog(day)
 	if err != nil {
 		return nil, err
@@ -143,14 +176,19 @@ func newModel(cfg app.Config) (*model, error) {
 	m := &model{
 		cfgQuestions:         append([]string(nil), cfg.Questions...),
 		config:               cfg,
+		settings:             cfg.Settings(),
+		readOnly:             opts.ReadOnly,
 		day:                  day,
 		log:                  log,
+		rangeStart:           day,
+		rangeEnd:             day,
 		showHints:            showHints,
 		autoInsert:           autoInsert,
 		continueAfterInsert:  continueAfterInsert,
 		listMode:             listModeDefault,
 		autoOpenIndex:        autoOpenIndex,
 		confirmDelete:        confirmDelete,
+		softDelete:           cfg.SoftDeleteEnabled(),
 		confirmEscape:        confirmEscape,
 		escapeConfirmTimeout: escapeConfirmTimeout,
 		statusTimeout:        statusTimeout,
@@ -169,7 +207,9 @@ func (m *model) Init() tea.Cmd {
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
-	if m.view == viewDetail && m.detail.editing {
+	// Keys answering a prompt must not also be typed into the entry.
+	_, isKey := msg.(tea.KeyMsg)
+	if m.view == viewDetail && m.detail.editing && !(isKey && m.promptActive()) {
 		var inputCmd tea.Cmd
 		m.detail.input, inputCmd = m.detail.input.Update(msg)
 		if inputCmd != nil {
@@ -208,17 +248,41 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, m.escapeConfirmTimer)
 		m.escapeConfirmTimer = nil
 	}
+	m.ensureSelectedVisible()
 
 	return m, tea.Batch(cmds...)
 }
 
+// promptActive reports whether a y/n or mood prompt is waiting for a key.
+func (m *model) promptActive() bool {
+	return m.reloadConfirmActive || m.moodPromptActive
+}
+
 func (m *model) View() string {
+	if m.tooSmall() {
+		return fmt.Sprintf("Terminal too small (need at least %dx%d)\n", minTerminalWidth, minTerminalHeight)
+	}
+
 	var b strings.Builder
-	dayLabel := m.day.Format("Mon 2006-01-02")
-	b.WriteString(fmt.Sprintf("%s — %s\n\n", dayLabel, relativeDayLabel(m.day)))
+	dayLabel := m.settings.FormatDayLabel(m.day)
+	b.WriteString(fmt.Sprintf("%s — %s", dayLabel, m.settings.RelativeDayLabel(m.day)))
+	if m.readOnly {
+		b.WriteString(" " + statusStyle.Render("[read-only]"))
+	}
+	if mood := app.FormatMood(m.log.Mood); mood != "" {
+		b.WriteString(" • " + mood)
+	}
+	if banner := m.dayBanner(); banner != "" {
+		b.WriteString("\n" + dayBannerStyle.Render(banner))
+	}
+	b.WriteString("\n\n")
 	if m.showHints {
 		b.WriteString("←/→ change day • space today • q quit • h/? toggle hints\n")
-		b.WriteString("Enter/i add entry • e edit • d delete entry • l toggle list • o open day file • numbers/letters jump\n\n")
+		if m.readOnly {
+			b.WriteString("Enter open question • l toggle list • x expand • numbers/letters jump\n\n")
+		} else {
+			b.WriteString("Enter/i add entry • e edit • d delete entry • p pin • m mood • l toggle list • x expand • o open day file • numbers/letters jump\n\n")
+		}
 	}
 
 	if m.err != nil {
@@ -232,20 +296,65 @@ func (m *model) View() string {
 		b.WriteString(m.renderDetail())
 	}
 
+	var footer strings.Builder
 	if m.showDeletePrompt {
-		b.WriteString("\n" + statusStyle.Render(m.confirmPrompt))
+		footer.WriteString("\n" + statusStyle.Render(m.confirmPrompt))
 	}
 
 	if m.escapeConfirmActive && m.escapeConfirmPrompt != "" {
-		b.WriteString("\n" + statusStyle.Render(m.escapeConfirmPrompt))
+		footer.WriteString("\n" + statusStyle.Render(m.escapeConfirmPrompt))
+	}
+
+	if m.moodPromptActive {
+		footer.WriteString("\n" + statusStyle.Render(fmt.Sprintf("Rate this day %d-%d (0 clears, esc cancels)", app.MinMood, app.MaxMood)))
+	}
+
+	if m.reloadConfirmActive {
+		footer.WriteString("\n" + statusStyle.Render("Day file changed on disk. Reload and discard the unsaved entry? (y/n)"))
 	}
 
 	if m.status != "" {
-		b.WriteString("\n" + statusStyle.Render(m.status))
+		footer.WriteString("\n" + statusStyle.Render(m.status))
+	}
+
+	if m.height == 0 || m.height >= statusBarMinHeight {
+		footer.WriteString("\n" + statusStyle.Render(m.statusBar()))
+	}
+
+	content := b.String()
+	if m.config.StatusPlacement() == app.StatusPositionBottom {
+		content += strings.Repeat("\n", bottomPadding(content, footer.String(), m.height))
 	}
 
 	// NOTE: Need to end with a newline for proper rendering
-	return b.String() + "\n"
+	return content + footer.String() + "\n"
+}
+
+// bottomPadding returns how many blank lines to insert between content and
+// footer so the footer ends on the last row above the trailing newline.
+func bottomPadding(content, footer string, height int) int {
+	if height <= 0 {
+		return 0
+	}
+	used := strings.Count(content, "\n") + strings.Count(footer, "\n") + 2
+	if used >= height {
+		return 0
+	}
+	return height - used
+}
+
+func (m *model) statusBar() string {
+	position := app.DaysBetween(m.rangeStart, m.day) + 1
+	span := app.DaysBetween(m.rangeStart, m.rangeEnd) + 1
+	entries := 0
+	for _, answers := range m.log.Answers {
+		entries += len(answers)
+	}
+	entryLabel := "entries"
+	if entries == 1 {
+		entryLabel = "entry"
+	}
+	return fmt.Sprintf("Day %d/%d in view • %d %s • %s", position, span, entries, entryLabel, m.settings.FormatDayLabel(m.day))
 }
 
 func (m *model) renderList() string {
@@ -259,7 +368,12 @@ func (m *model) renderList() string {
 		b.WriteString("List mode: showing entries for all questions.\n\n")
 	}
 
-	for i, row := range m.rows {
+	start, end := m.visibleRowRange()
+	if start > 0 {
+		b.WriteString(statusStyle.Render(fmt.Sprintf("  ↑ %d more", start)) + "\n")
+	}
+	for i := start; i < end; i++ {
+		row := m.rows[i]
 		marker := " "
 		if i == m.selected {
 			marker = ">"
@@ -275,16 +389,20 @@ func (m *model) renderList() string {
 			if count > 0 {
 				countLabel = fmt.Sprintf(" (%d)", count)
 			}
-			b.WriteString(fmt.Sprintf("%s [%s] %s%s\n", marker, label, row.question, countLabel))
+			b.WriteString(fmt.Sprintf("%s [%s] %s%s\n", marker, label, m.styledQuestion(row.question), countLabel))
 		case rowEntry:
 			answers := m.log.Answers[row.question]
 			if row.entryIndex >= 0 && row.entryIndex < len(answers) {
 				ans := answers[row.entryIndex]
-				b.WriteString(fmt.Sprintf("%s     - [%s] %s\n", marker, app.DisplayTime(ans.Time), ans.Response))
+				b.WriteString(fmt.Sprintf("%s     - %s%s\n", marker, pinMarker(ans), priorityLine(ans, fmt.Sprintf("[%s] %s", m.settings.DisplayTime(ans.Time), ans.Response))))
 			}
 		}
 	}
 
+	if end < len(m.rows) {
+		b.WriteString(statusStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.rows)-end)) + "\n")
+	}
+
 	if m.showHints && len(m.rows) > 0 {
 		hint := "Use numbers/letters to jump to a question. Enter on an entry opens the editor. Press d to delete an entry."
 		b.WriteString("\n" + hint + "\n")
@@ -293,26 +411,64 @@ func (m *model) renderList() string {
 	return b.String()
 }
 
+func (m *model) styledQuestion(question string) string {
+	style := m.config.QuestionStyle(question)
+	text := app.RenderQuestion(question, m.day)
+	if style.Color != "" {
+		text = lipgloss.NewStyle().Foreground(lipgloss.Color(style.Color)).Render(text)
+	}
+	if style.Icon != "" {
+		text = style.Icon + " " + text
+	}
+	return text
+}
+
+// priorityLine styles an entry line by its priority. lipgloss drops the
+// styling on its own when the terminal has no color support.
+func priorityLine(ans app.Answer, line string) string {
+	switch ans.Priority {
+	case app.PriorityHigh:
+		return highPriorityStyle.Render(line)
+	case app.PriorityLow:
+		return lowPriorityStyle.Render(line)
+	default:
+		return line
+	}
+}
+
+func pinMarker(ans app.Answer) string {
+	if ans.Pinned {
+		return "* "
+	}
+	return ""
+}
+
 func (m *model) renderDetail() string {
 	var b strings.Builder
-	b.WriteString(fmt.Sprintf("%s\n\n", m.detail.question))
+	b.WriteString(fmt.Sprintf("%s\n\n", app.RenderQuestion(m.detail.question, m.day)))
 	entries := m.log.Answers[m.detail.question]
 	if len(entries) == 0 {
 		b.WriteString("  No entries yet.\n")
 	}
-	for i, ans := range entries {
-		b.WriteString(fmt.Sprintf("  %d. [%s] %s\n", i+1, app.DisplayTime(ans.Time), ans.Response))
+	for i, idx := range app.PinnedOrder(entries) {
+		ans := entries[idx]
+		b.WriteString(fmt.Sprintf("  %d. %s%s\n", i+1, pinMarker(ans), priorityLine(ans, fmt.Sprintf("[%s] %s", m.settings.DisplayTime(ans.Time), ans.Response))))
 	}
 
 	b.WriteString("\n")
 	if m.detail.editing {
 		b.WriteString("New entry:\n  ")
 		b.WriteString(m.detail.input.View())
-		if m.showHints {
-			b.WriteString("\n  Enter to save and continue, Esc to cancel.\n")
+		b.WriteString("\n  " + statusStyle.Render(m.entryCountLabel()))
+		if m.showHints && m.continueAfterInsert {
+			b.WriteString("\n  Enter to save and continue, Esc to cancel, ctrl+o to open the day file.\n")
+		} else if m.showHints {
+			b.WriteString("\n  Enter to save, Esc to cancel, ctrl+o to open the day file.\n")
 		} else {
 			b.WriteString("\n")
 		}
+	} else if m.showHints && m.readOnly {
+		b.WriteString("Press Esc to go back.\n")
 	} else if m.showHints {
 		b.WriteString("Press Enter or i to start adding entries, e to edit all entries, Esc to go back.\n")
 	}
@@ -320,14 +476,71 @@ func (m *model) renderDetail() string {
 	return b.String()
 }
 
+// dayBanner warns that entries will not land on today. It is empty when
+// viewing today.
+func (m *model) dayBanner() string {
+	today := app.DayFloor(time.Now())
+	verb := "Logging to"
+	if m.readOnly {
+		verb = "Viewing"
+	}
+	switch {
+	case m.day.Before(today):
+		return fmt.Sprintf("⚠ %s a PAST day", verb)
+	case m.day.After(today):
+		return fmt.Sprintf("⚠ %s a FUTURE day", verb)
+	}
+	return ""
+}
+
+// tooSmall reports whether the last known terminal size is below what the
+// views need. A zero size means no size has been reported yet.
+func (m *model) tooSmall() bool {
+	if m.width == 0 && m.height == 0 {
+		return false
+	}
+	return m.width < minTerminalWidth || m.height < minTerminalHeight
+}
+
+func (m *model) entryCountLabel() string {
+	chars, words := countEntryText(m.detail.input.Value())
+	label := fmt.Sprintf("%d chars • %d words", chars, words)
+	if limit := m.config.EntrySoftLimit(); limit > 0 && chars > limit {
+		label += fmt.Sprintf(" • over soft limit of %d", limit)
+	}
+	return label
+}
+
 func (m *model) handleKey(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
 
+	// ctrl+c dismisses a pending prompt and then quits as usual.
+	if key == "ctrl+c" && m.promptActive() {
+		m.reloadConfirmActive = false
+		m.moodPromptActive = false
+	}
+
+	if m.reloadConfirmActive {
+		m.handleReloadConfirmationKey(key)
+		return nil
+	}
+
+	if m.moodPromptActive {
+		m.handleMoodKey(key)
+		return nil
+	}
+
 	if m.view == viewDetail && m.detail.editing {
 		switch key {
 		case "ctrl+c":
+			if m.shouldConfirmEscape() && !m.quitConfirmActive {
+				m.quitConfirmActive = true
+				m.setStatus("Unsaved entry. Press ctrl+c again to quit or Enter to save it.")
+				return nil
+			}
 			return tea.Quit
 		default:
+			m.quitConfirmActive = false
 			goto viewHandling
 		}
 	}
@@ -376,6 +589,9 @@ viewHandling:
 
 func (m *model) handleListKey(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
+	if m.readOnly && m.blockReadOnlyKey(key, "i", "e", "d", "o") {
+		return nil
+	}
 	switch key {
 	case "up":
 		m.moveSelection(-1)
@@ -410,6 +626,28 @@ func (m *model) handleListKey(msg tea.KeyMsg) tea.Cmd {
 		m.handleDeleteEntryRequest()
 	case "l":
 		m.toggleListMode()
+	case "x":
+		if m.disableJKNav {
+			m.jumpToIndex('x')
+		} else {
+			m.toggleExpandedQuestion()
+		}
+	case "p":
+		if m.disableJKNav {
+			m.jumpToIndex('p')
+		} else if m.readOnly {
+			m.blockReadOnlyKey(key, "p")
+		} else {
+			m.togglePin()
+		}
+	case "m":
+		if m.disableJKNav {
+			m.jumpToIndex('m')
+		} else if m.readOnly {
+			m.blockReadOnlyKey(key, "m")
+		} else {
+			m.moodPromptActive = true
+		}
 	case "o":
 		return m.openDayJSON()
 	default:
@@ -431,8 +669,8 @@ func (m *model) handleListKey(msg tea.KeyMsg) tea.Cmd {
 }
 
 func (m *model) handleDeleteEntryRequest() {
-	if !m.listMode {
-		m.setStatus("Enable list mode to delete entries.")
+	if !m.listMode && m.expandedQuestion == "" {
+		m.setStatus("Press x to expand this question or l for list mode to delete entries.")
 		return
 	}
 	row := m.currentRow()
@@ -481,18 +719,11 @@ func (m *model) handleDeleteConfirmationKey(key string) bool {
 }
 
 func (m *model) performDeleteEntry(question string, idx int) {
-	entries := m.log.Answers[question]
-	if idx < 0 || idx >= len(entries) {
+	if !app.DeleteAnswer(&m.log, question, idx, m.softDelete) {
 		m.setStatus("Entry not found.")
 		return
 	}
-	entries = append(entries[:idx], entries[idx+1:]...)
-	if len(entries) == 0 {
-		delete(m.log.Answers, question)
-	} else {
-		m.log.Answers[question] = entries
-	}
-	if err := app.SaveDayLog(m.day, m.log); err != nil {
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
 		m.err = err
 		m.setStatus("Failed to delete entry.")
 		return
@@ -505,11 +736,78 @@ func (m *model) performDeleteEntry(question string, idx int) {
 	m.selectQuestionByName(question)
 }
 
+func (m *model) togglePin() {
+	row := m.currentRow()
+	if row == nil || row.kind != rowEntry {
+		m.setStatus("Select an entry to pin (press x or l to show entries).")
+		return
+	}
+	entries := m.log.Answers[row.question]
+	if row.entryIndex < 0 || row.entryIndex >= len(entries) {
+		m.setStatus("Entry not found.")
+		return
+	}
+	question, entryIndex := row.question, row.entryIndex
+	entries[entryIndex].Pinned = !entries[entryIndex].Pinned
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
+		entries[entryIndex].Pinned = !entries[entryIndex].Pinned
+		m.err = err
+		return
+	}
+	m.err = nil
+	if entries[entryIndex].Pinned {
+		m.setStatus("Entry pinned.")
+	} else {
+		m.setStatus("Entry unpinned.")
+	}
+	m.rebuildRows()
+	for i, r := range m.rows {
+		if r.kind == rowEntry && r.question == question && r.entryIndex == entryIndex {
+			m.selected = i
+			break
+		}
+	}
+}
+
+func (m *model) handleMoodKey(key string) {
+	switch key {
+	case "esc":
+		m.moodPromptActive = false
+		return
+	case "0", "backspace":
+		m.setMood(0)
+		return
+	}
+	mood, err := strconv.Atoi(key)
+	if err != nil || app.ValidateMood(mood) != nil {
+		m.setStatus(fmt.Sprintf("Press %d-%d to rate the day, 0 to clear, or esc to cancel.", app.MinMood, app.MaxMood))
+		return
+	}
+	m.setMood(mood)
+}
+
+func (m *model) setMood(mood int) {
+	m.moodPromptActive = false
+	previous := m.log.Mood
+	m.log.Mood = mood
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
+		m.log.Mood = previous
+		m.err = err
+		return
+	}
+	m.err = nil
+	if mood == 0 {
+		m.setStatus("Mood cleared.")
+		return
+	}
+	m.setStatus(fmt.Sprintf("Mood set to %d/%d.", mood, app.MaxMood))
+}
+
 func (m *model) openDayJSON() tea.Cmd {
 	if m.log.Answers == nil {
 		m.log.Answers = make(map[string][]app.Answer)
 	}
-	if err := app.SaveDayLog(m.day, m.log); err != nil {
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
 		m.err = err
 		return nil
 	}
@@ -524,6 +822,9 @@ func (m *model) openDayJSON() tea.Cmd {
 
 func (m *model) handleDetailKey(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
+	if m.readOnly && m.blockReadOnlyKey(key, "enter", "i", "e", "ctrl+o") {
+		return nil
+	}
 	if m.detail.editing && key != "esc" && m.escapeConfirmActive {
 		m.clearEscapeConfirmPrompt()
 	}
@@ -565,22 +866,58 @@ func (m *model) handleDetailKey(msg tea.KeyMsg) tea.Cmd {
 		if !m.detail.editing {
 			return m.openQuestionEditor(m.detail.question)
 		}
+	case "ctrl+o":
+		return m.openDayJSON()
+	default:
+		if !m.detail.editing && m.autoOpenIndex && len(key) == 1 {
+			m.jumpToDetail([]rune(key)[0])
+		}
 	}
 	return nil
 }
 
+func (m *model) jumpToDetail(r rune) {
+	if unicode.IsLetter(r) {
+		r = unicode.ToLower(r)
+	}
+	idx, ok := runeToIndex(r)
+	if !ok || idx >= len(m.questions) {
+		return
+	}
+	question := m.questions[idx]
+	if question == m.detail.question {
+		return
+	}
+	m.selectQuestionByIndex(idx)
+	m.openDetail(question, m.autoInsert && !m.readOnly)
+}
+
 func (m *model) activateSelection() tea.Cmd {
 	row := m.currentRow()
 	if row == nil {
 		return nil
 	}
 	if row.kind == rowEntry {
+		if m.readOnly {
+			m.setStatus("Read-only mode: editing is disabled.")
+			return nil
+		}
 		return m.openEntryEditor(row.question, row.entryIndex)
 	}
-	m.openDetail(row.question, m.autoInsert)
+	m.openDetail(row.question, m.autoInsert && !m.readOnly)
 	return nil
 }
 
+func (m *model) blockReadOnlyKey(key string, mutating ...string) bool {
+	for _, candidate := range mutating {
+		if key == candidate {
+			m.setStatus("Read-only mode: editing is disabled.")
+			return true
+		}
+	}
+	return false
+}
+
 func (m *model) openDetail(question string, startEditing bool) {
 	m.view = viewDetail
 	m.deleteConfirm = nil
@@ -629,9 +966,9 @@ func (m *model) requestEscapeConfirmPrompt() {
 	m.escapeConfirmActive = true
 	m.escapeConfirmSeq++
 	if m.escapeConfirmTimeout > 0 {
-		m.escapeConfirmPrompt = fmt.Sprintf("Press Esc again within %s to cancel entry.", m.escapeConfirmTimeout)
+		m.escapeConfirmPrompt = fmt.Sprintf("Press Esc again within %s to discard entry.", m.escapeConfirmTimeout)
 	} else {
-		m.escapeConfirmPrompt = "Press Esc again to cancel entry."
+		m.escapeConfirmPrompt = "Press Esc again to discard entry."
 	}
 	if m.escapeConfirmTimeout <= 0 {
 		return
@@ -654,12 +991,21 @@ func (m *model) saveInlineEntry() {
 		m.setStatus("Entry discarded (empty).")
 		return
 	}
+	if err := m.config.CheckEntryDay(m.day); err != nil {
+		m.setStatus(err.Error())
+		return
+	}
 	if m.log.Answers == nil {
 		m.log.Answers = make(map[string][]app.Answer)
 	}
-	entry := app.Answer{Time: time.Now().Format(time.RFC3339), Response: text}
+	if m.config.DedupeEntriesEnabled() && app.HasResponse(m.log.Answers[m.detail.question], text) {
+		m.detail.input.SetValue("")
+		m.setStatus("Duplicate skipped.")
+		return
+	}
+	entry := app.Answer{Time: m.settings.EntryTimestamp(time.Now()), Response: text}
 	m.log.Answers[m.detail.question] = append(m.log.Answers[m.detail.question], entry)
-	if err := app.SaveDayLog(m.day, m.log); err != nil {
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
 		m.err = err
 		return
 	}
@@ -723,20 +1069,53 @@ func (m *model) handleExternalOpenResult(msg externalOpenResultMsg) {
 	m.err = nil
 	switch msg.kind {
 	case openKindDay:
+		if m.detail.editing && strings.TrimSpace(m.detail.input.Value()) != "" {
+			m.reloadConfirmActive = true
+			return
+		}
 		m.refreshCurrentDayFromDisk()
 		m.setStatus("Day file reloaded.")
 	}
 }
 
+// handleReloadConfirmationKey resolves a reload that would drop an unsaved
+// entry. Keeping the entry also keeps the in-memory day, so saving it will
+// overwrite the external changes.
+func (m *model) handleReloadConfirmationKey(key string) {
+	switch key {
+	case "y", "Y":
+		m.reloadConfirmActive = false
+		m.stopInlineEditing()
+		m.refreshCurrentDayFromDisk()
+		m.setStatus("Day file reloaded, unsaved entry discarded.")
+	case "n", "N", "esc":
+		m.reloadConfirmActive = false
+		m.setStatus("Kept the unsaved entry; saving will overwrite the external changes.")
+	default:
+		m.setStatus("Reload with y, or keep the unsaved entry with n.")
+	}
+}
+
 func (m *model) applyQuestionEdit(question string, responses []string) {
 	existing := m.log.Answers[question]
-	updated := rebuildAnswers(existing, responses)
+	updated, dropped := rebuildAnswers(existing, responses, m.settings.EntryTimestamp(time.Now()))
+	// Any response that did not match an existing answer is a new entry,
+	// including one that replaces an edited response.
+	if created := len(updated) - (len(existing) - len(dropped)); created > 0 {
+		if err := m.config.CheckEntryDay(m.day); err != nil {
+			m.setStatus(err.Error())
+			return
+		}
+	}
+	if m.softDelete {
+		app.TrashAnswers(&m.log, question, dropped)
+	}
 	if len(updated) == 0 {
 		delete(m.log.Answers, question)
 	} else {
 		m.log.Answers[question] = updated
 	}
-	if err := app.SaveDayLog(m.day, m.log); err != nil {
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
 		m.err = err
 		return
 	}
@@ -751,16 +1130,11 @@ func (m *model) applySingleEntryEdit(question string, idx int, responses []strin
 		return
 	}
 	if len(responses) == 0 {
-		answers = append(answers[:idx], answers[idx+1:]...)
+		app.DeleteAnswer(&m.log, question, idx, m.softDelete)
 	} else {
 		answers[idx].Response = responses[0]
 	}
-	if len(answers) == 0 {
-		delete(m.log.Answers, question)
-	} else {
-		m.log.Answers[question] = answers
-	}
-	if err := app.SaveDayLog(m.day, m.log); err != nil {
+	if err := app.SaveDayLog(m.day, m.log, m.settings); err != nil {
 		m.err = err
 		return
 	}
@@ -795,6 +1169,81 @@ func (m *model) moveSelection(delta int) {
 		next = len(m.rows) - 1
 	}
 	m.selected = next
+	m.ensureSelectedVisible()
+}
+
+// listCapacity estimates how many list rows fit on screen once the header,
+// hints, and status lines are accounted for.
+func (m *model) listCapacity() int {
+	return m.listCapacityAt(m.scrollOffset)
+}
+
+// listCapacityAt returns how many rows fit with the list scrolled to offset,
+// leaving a line for each "↑/↓ N more" indicator that offset shows.
+func (m *model) listCapacityAt(offset int) int {
+	if m.height <= 0 {
+		return len(m.rows)
+	}
+	available := m.height - m.reservedLines()
+	if offset > 0 {
+		available--
+	}
+	if len(m.rows)-offset > available {
+		available--
+	}
+	return max(1, available)
+}
+
+// reservedLines counts the lines View writes around the list rows.
+func (m *model) reservedLines() int {
+	// Header, blank line, status, status bar and the trailing newline.
+	reserved := 5
+	if m.dayBanner() != "" {
+		reserved++
+	}
+	if m.showHints {
+		reserved += 5
+		if m.listMode {
+			reserved += 2
+		}
+	}
+	if m.err != nil {
+		reserved += 2
+	}
+	for _, prompt := range []bool{m.showDeletePrompt, m.escapeConfirmActive && m.escapeConfirmPrompt != "", m.moodPromptActive, m.reloadConfirmActive} {
+		if prompt {
+			reserved++
+		}
+	}
+	return reserved
+}
+
+func (m *model) ensureSelectedVisible() {
+	if m.selected < m.scrollOffset {
+		m.scrollOffset = m.selected
+	}
+	for m.scrollOffset < m.selected && m.selected >= m.scrollOffset+m.listCapacityAt(m.scrollOffset) {
+		m.scrollOffset++
+	}
+	// Scroll back up while the rows from there to the end still fit.
+	for m.scrollOffset > 0 && len(m.rows)-(m.scrollOffset-1) <= m.listCapacityAt(m.scrollOffset-1) {
+		m.scrollOffset--
+	}
+	if m.scrollOffset < 0 {
+		m.scrollOffset = 0
+	}
+}
+
+func (m *model) visibleRowRange() (int, int) {
+	start := m.scrollOffset
+	if start > len(m.rows) {
+		start = len(m.rows)
+	}
+	end := start + m.listCapacity()
+	if end > len(m.rows) {
+		end = len(m.rows)
+	}
+	return start, end
 }
 
 func (m *model) jumpToIndex(r rune) bool {
@@ -833,7 +1282,7 @@ func (m *model) rowIndexForQuestion(idx int) int {
 	if idx < 0 || idx >= len(m.questions) {
 		return -1
 	}
-	if !m.listMode {
+	if !m.listMode && m.expandedQuestion == "" {
 		return idx
 	}
 	offset := 0
@@ -842,11 +1291,41 @@ func (m *model) rowIndexForQuestion(idx int) int {
 			return offset
 		}
 		offset++
-		offset += len(m.log.Answers[m.questions[i]])
+		if m.questionExpanded(m.questions[i]) {
+			offset += len(m.log.Answers[m.questions[i]])
+		}
 	}
 	return -1
 }
 
+// questionExpanded reports whether a question's entries are shown as rows,
+// either because list mode is on or because it was expanded with x.
+func (m *model) questionExpanded(question string) bool {
+	return m.listMode || question == m.expandedQuestion
+}
+
+// toggleExpandedQuestion shows the selected question's entries inline
+// without turning on list mode. Only one question is expanded at a time,
+// and the expansion is dropped when the day or list mode changes.
+func (m *model) toggleExpandedQuestion() {
+	row := m.currentRow()
+	if row == nil {
+		return
+	}
+	if m.listMode {
+		m.setStatus("List mode already shows every entry.")
+		return
+	}
+	question := row.question
+	if m.expandedQuestion == question {
+		m.expandedQuestion = ""
+	} else {
+		m.expandedQuestion = question
+	}
+	m.rebuildRows()
+	m.selectQuestionByName(question)
+}
+
 func (m *model) currentRow() *listRow {
 	if len(m.rows) == 0 || m.selected < 0 || m.selected >= len(m.rows) {
 		return nil
@@ -860,6 +1339,7 @@ func (m *model) toggleListMode() {
 		currentQuestion = row.question
 	}
 	m.listMode = !m.listMode
+	m.expandedQuestion = ""
 	m.refreshQuestions()
 	if currentQuestion != "" {
 		if idx, ok := m.questionIndex[currentQuestion]; ok {
@@ -911,8 +1391,8 @@ func (m *model) rebuildRows() {
 	rows := make([]listRow, 0, len(m.questions))
 	for _, q := range m.questions {
 		rows = append(rows, listRow{kind: rowQuestion, question: q})
-		if m.listMode {
-			for idx := range m.log.Answers[q] {
+		if m.questionExpanded(q) {
+			for _, idx := range app.PinnedOrder(m.log.Answers[q]) {
 				rows = append(rows, listRow{kind: rowEntry, question: q, entryIndex: idx})
 			}
 		}
@@ -921,18 +1401,29 @@ func (m *model) rebuildRows() {
 }
 
 func (m *model) changeDay(delta int) {
-	m.day = m.day.AddDate(0, 0, delta)
+	m.setDay(m.day.AddDate(0, 0, delta))
 	m.reloadDay()
 }
 
 func (m *model) goToToday() {
 	today := app.DayFloor(time.Now())
 	if !today.Equal(m.day) {
-		m.day = today
+		m.setDay(today)
 		m.reloadDay()
 	}
 }
 
+// setDay moves to day and widens the navigated range to include it.
+func (m *model) setDay(day time.Time) {
+	m.day = day
+	if day.Before(m.rangeStart) {
+		m.rangeStart = day
+	}
+	if day.After(m.rangeEnd) {
+		m.rangeEnd = day
+	}
+}
+
 func (m *model) reloadDay() {
 	log, err := app.LoadDayLog(m.day)
 	if err != nil {
@@ -945,6 +1436,7 @@ func (m *model) reloadDay() {
 	}
 	m.log = log
 	m.view = viewList
+	m.expandedQuestion = ""
 	m.stopInlineEditing()
 	m.selected = 0
 	m.refreshQuestions()
@@ -996,21 +1488,9 @@ func runeToIndex(r rune) (int, bool) {
 	return 0, false
 }
 
-func relativeDayLabel(day time.Time) string {
-	today := app.DayFloor(time.Now())
-	switch {
-	case day.Equal(today):
-		return "Today"
-	case day.Equal(today.AddDate(0, 0, -1)):
-		return "Yesterday"
-	case day.Equal(today.AddDate(0, 0, 1)):
-		return "Tomorrow"
-	}
-	delta := int(day.Sub(today).Hours() / 24)
-	if delta > 0 {
-		return fmt.Sprintf("In %d days", delta)
-	}
-	return fmt.Sprintf("%d days ago", -delta)
+func countEntryText(value string) (int, int) {
+	trimmed := strings.TrimSpace(value)
+	return utf8.RuneCountInString(trimmed), len(strings.Fields(trimmed))
 }
 
 func responsesForQuestion(entries []app.Answer) []string {
@@ -1021,28 +1501,36 @@ func responsesForQuestion(entries []app.Answer) []string {
 	return lines
 }
 
-func rebuildAnswers(existing []app.Answer, responses []string) []app.Answer {
-	if len(responses) == 0 {
-		return nil
-	}
-	pool := make(map[string][]string)
-	for _, ans := range existing {
-		pool[ans.Response] = append(pool[ans.Response], ans.Time)
+// rebuildAnswers keeps the existing answer for each unchanged response and
+// stamps new responses with timestamp. It also returns the existing answers
+// whose response is gone, in their original order.
+func rebuildAnswers(existing []app.Answer, responses []string, timestamp string) ([]app.Answer, []app.Answer) {
+	pool := make(map[string][]int)
+	for i, ans := range existing {
+		pool[ans.Response] = append(pool[ans.Response], i)
 	}
+	kept := make([]bool, len(existing))
 	var result []app.Answer
 	for _, resp := range responses {
 		resp = strings.TrimSpace(resp)
 		if resp == "" {
 			continue
 		}
-		timestamp := time.Now().Format(time.RFC3339)
-		if times := pool[resp]; len(times) > 0 {
-			timestamp = times[0]
-			pool[resp] = times[1:]
+		ans := app.Answer{Time: timestamp, Response: resp}
+		if matches := pool[resp]; len(matches) > 0 {
+			ans = existing[matches[0]]
+			kept[matches[0]] = true
+			pool[resp] = matches[1:]
+		}
+		result = append(result, ans)
+	}
+	var dropped []app.Answer
+	for i, ans := range existing {
+		if !kept[i] {
+			dropped = append(dropped, ans)
 		}
-		result = append(result, app.Answer{Time: timestamp, Response: resp})
 	}
-	return result
+	return result, dropped
 }
 
 func boolPtr(v bool) *bool {
@@ -0,0 +1,919 @@
+package tuiapp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/almahoozi/wlog/internal/app"
+)
+
+func TestApplyQuestionEditSoftDeletesDroppedAnswers(t *testing.T) {
+	m := newTestModel(t, app.Config{SoftDelete: boolPtr(true)}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("keep", "drop", "edit")}})
+
+	m.applyQuestionEdit("Q1", []string{"keep", "edited", "new"})
+
+	log := reloadDay(t)
+	var got []string
+	for _, ans := range log.Answers["Q1"] {
+		got = append(got, ans.Response)
+	}
+	if want := []string{"keep", "edited", "new"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("answers = %v, want %v", got, want)
+	}
+	var trashed []string
+	for _, ans := range log.Trash {
+		if ans.Question != "Q1" || ans.DeletedAt == "" {
+			t.Errorf("bad trash entry %+v", ans)
+		}
+		trashed = append(trashed, ans.Response)
+	}
+	if want := []string{"drop", "edit"}; !reflect.DeepEqual(trashed, want) {
+		t.Fatalf("trash = %v, want %v", trashed, want)
+	}
+
+	if err := app.RunTrash([]string{"restore", testDay.Format("2006-01-02")}, m.settings, false); err != nil {
+		t.Fatal(err)
+	}
+	restored := reloadDay(t)
+	if len(restored.Trash) != 0 {
+		t.Fatalf("trash not emptied: %+v", restored.Trash)
+	}
+	got = got[:0]
+	for _, ans := range restored.Answers["Q1"] {
+		got = append(got, ans.Response)
+	}
+	sort.Strings(got)
+	if want := []string{"drop", "edit", "edited", "keep", "new"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("restored answers = %v, want %v", got, want)
+	}
+}
+
+func TestApplyQuestionEditClearingAllSoftDeletes(t *testing.T) {
+	m := newTestModel(t, app.Config{SoftDelete: boolPtr(true)}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("a", "b")}})
+
+	m.applyQuestionEdit("Q1", nil)
+
+	log := reloadDay(t)
+	if _, ok := log.Answers["Q1"]; ok {
+		t.Fatalf("question kept: %v", log.Answers)
+	}
+	if len(log.Trash) != 2 {
+		t.Fatalf("trash = %+v, want both answers", log.Trash)
+	}
+}
+
+func TestApplyQuestionEditHardDeletesWithoutSoftDelete(t *testing.T) {
+	m := newTestModel(t, app.Config{SoftDelete: boolPtr(false)}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("keep", "drop")}})
+
+	m.applyQuestionEdit("Q1", []string{"keep"})
+
+	log := reloadDay(t)
+	if len(log.Trash) != 0 {
+		t.Fatalf("trash = %+v, want none", log.Trash)
+	}
+	if len(log.Answers["Q1"]) != 1 {
+		t.Fatalf("answers = %+v", log.Answers["Q1"])
+	}
+}
+
+func TestRebuildAnswersKeepsDuplicates(t *testing.T) {
+	existing := answers("same", "same", "other")
+	updated, dropped := rebuildAnswers(existing, []string{"same", " other "}, "2026-03-02T12:00:00Z")
+	if !reflect.DeepEqual(updated, []app.Answer{existing[0], existing[2]}) {
+		t.Fatalf("updated = %+v", updated)
+	}
+	if !reflect.DeepEqual(dropped, []app.Answer{existing[1]}) {
+		t.Fatalf("dropped = %+v", dropped)
+	}
+}
+
+func TestDayKeysGoToInputWhileEditing(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	m.openDetail("Q1", true)
+	for _, r := range "draft" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	for _, msg := range []tea.KeyMsg{{Type: tea.KeyLeft}, {Type: tea.KeyRight}, {Type: tea.KeySpace, Runes: []rune{' '}}} {
+		m.Update(msg)
+		if !m.day.Equal(testDay) {
+			t.Fatalf("%q changed the day to %s while editing", msg.String(), m.day.Format("2006-01-02"))
+		}
+		if !m.detail.editing {
+			t.Fatalf("%q stopped editing", msg.String())
+		}
+	}
+	if got := m.detail.input.Value(); got != "draft " {
+		t.Fatalf("input = %q, want the space typed after the draft", got)
+	}
+}
+
+func TestDayKeysChangeDayInList(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if want := testDay.AddDate(0, 0, -1); !m.day.Equal(want) {
+		t.Fatalf("day = %s, want %s", m.day.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if want := testDay.AddDate(0, 0, 1); !m.day.Equal(want) {
+		t.Fatalf("day = %s, want %s", m.day.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+}
+
+func TestExternalDayEditWithUnsavedEntry(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("before")}})
+	m.openDetail("Q1", true)
+	for _, r := range "draft" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	external := func(response string) {
+		t.Helper()
+		log := reloadDay(t)
+		log.Answers["Q2"] = append(log.Answers["Q2"], answers(response)...)
+		if err := app.SaveDayLog(testDay, log, m.settings); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlO}); cmd == nil {
+		t.Fatal("ctrl+o did not open the day file while editing")
+	}
+	external("outside")
+	m.Update(externalOpenResultMsg{kind: openKindDay})
+	if !m.reloadConfirmActive {
+		t.Fatal("reload did not ask before discarding the unsaved entry")
+	}
+	if len(m.log.Answers["Q2"]) != 0 {
+		t.Fatal("day reloaded before the prompt was answered")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.reloadConfirmActive {
+		t.Fatal("n did not close the prompt")
+	}
+	if got := m.detail.input.Value(); got != "draft" {
+		t.Fatalf("input = %q, the answer to the prompt was typed into the entry", got)
+	}
+	if !m.detail.editing || len(m.log.Answers["Q2"]) != 0 {
+		t.Fatal("keeping the entry reloaded the day")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	external("again")
+	m.Update(externalOpenResultMsg{kind: openKindDay})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if m.reloadConfirmActive || m.detail.editing {
+		t.Fatal("y did not discard the entry")
+	}
+	if len(m.log.Answers["Q2"]) != 1 {
+		t.Fatalf("day not reloaded: %+v", m.log.Answers)
+	}
+}
+
+func TestExternalDayEditWithoutUnsavedEntryReloads(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("before")}})
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}}); cmd == nil {
+		t.Fatal("o did not open the day file from the list")
+	}
+	log := reloadDay(t)
+	log.Answers["Q2"] = answers("outside")
+	if err := app.SaveDayLog(testDay, log, m.settings); err != nil {
+		t.Fatal(err)
+	}
+	m.Update(externalOpenResultMsg{kind: openKindDay})
+	if m.reloadConfirmActive {
+		t.Fatal("asked to confirm without an unsaved entry")
+	}
+	if len(m.log.Answers["Q2"]) != 1 {
+		t.Fatalf("day not reloaded: %+v", m.log.Answers)
+	}
+}
+
+func TestMoodKeySetsMood(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	if !m.moodPromptActive {
+		t.Fatal("m did not open the mood prompt")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
+	if m.moodPromptActive {
+		t.Fatal("prompt still open after rating")
+	}
+	if got := reloadDay(t).Mood; got != 4 {
+		t.Fatalf("saved mood = %d, want 4", got)
+	}
+}
+
+func TestCtrlCQuitsFromPrompts(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	m.moodPromptActive = true
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Fatal("ctrl+c was swallowed by the mood prompt")
+	}
+
+	m.moodPromptActive = false
+	m.reloadConfirmActive = true
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Fatal("ctrl+c was swallowed by the reload prompt")
+	}
+}
+
+func TestStatusBarRangePositionAcrossDST(t *testing.T) {
+	cairo, err := time.LoadLocation("Africa/Cairo")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	// Egypt moves its clocks forward at midnight on Friday 2026-04-24, so
+	// Saturday starts 119 hours after Monday.
+	m.rangeStart = time.Date(2026, 4, 20, 0, 0, 0, 0, cairo)
+	m.rangeEnd = time.Date(2026, 4, 26, 0, 0, 0, 0, cairo)
+	for day, want := range map[int]string{20: "Day 1/7", 24: "Day 5/7", 25: "Day 6/7", 26: "Day 7/7"} {
+		m.day = time.Date(2026, 4, day, 0, 0, 0, 0, cairo)
+		if got := m.statusBar(); !strings.HasPrefix(got, want+" ") {
+			t.Errorf("statusBar for 2026-04-%d = %q, want %s", day, got, want)
+		}
+	}
+}
+
+func TestStyledQuestion(t *testing.T) {
+	cfg := app.Config{
+		Questions: []string{"Q1", "Q2", "Q3"},
+		QuestionStyles: map[string]app.QuestionStyle{
+			"Q1": {Icon: "★", Color: "205"},
+			"Q2": {Icon: "•"},
+		},
+	}
+	m := newTestModel(t, cfg, app.DayLog{})
+	if got := m.styledQuestion("Q1"); !strings.HasPrefix(got, "★ ") || !strings.Contains(got, "Q1") {
+		t.Errorf("styledQuestion(Q1) = %q, want the icon before the question", got)
+	}
+	if got := m.styledQuestion("Q2"); got != "• Q2" {
+		t.Errorf("styledQuestion(Q2) = %q, want the icon and default styling", got)
+	}
+	if got := m.styledQuestion("Q3"); got != "Q3" {
+		t.Errorf("styledQuestion(Q3) = %q, want the plain question", got)
+	}
+	if view := m.View(); !strings.Contains(view, "★ ") || !strings.Contains(view, "• Q2") {
+		t.Errorf("list view is missing the icons:\n%s", view)
+	}
+}
+
+func TestStatusBarContent(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{Answers: map[string][]app.Answer{
+		"Q1":    answers("a", "b"),
+		"Extra": answers("c"),
+	}})
+	want := "Day 1/1 in view • 3 entries • " + m.settings.FormatDayLabel(testDay)
+	if got := m.statusBar(); got != want {
+		t.Errorf("statusBar = %q, want %q", got, want)
+	}
+
+	m.changeDay(-2)
+	m.log = app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("a")}}
+	want = "Day 1/3 in view • 1 entry • " + m.settings.FormatDayLabel(m.day)
+	if got := m.statusBar(); got != want {
+		t.Errorf("statusBar = %q, want %q", got, want)
+	}
+
+	m.changeDay(6)
+	if got := m.statusBar(); !strings.HasPrefix(got, "Day 7/7 in view ") {
+		t.Errorf("statusBar after widening the range = %q, want Day 7/7", got)
+	}
+	m.changeDay(-4)
+	if got := m.statusBar(); !strings.HasPrefix(got, "Day 3/7 in view ") {
+		t.Errorf("statusBar inside the range = %q, want Day 3/7", got)
+	}
+
+	m.width, m.height = 100, statusBarMinHeight
+	if !strings.Contains(m.View(), "Day 3/7 in view") {
+		t.Error("status bar missing at the minimum height")
+	}
+	m.height = statusBarMinHeight - 1
+	if strings.Contains(m.View(), "Day 3/7 in view") {
+		t.Error("status bar shown below the minimum height")
+	}
+}
+
+func TestQuitWhileEditing(t *testing.T) {
+	m := newTestModel(t, app.Config{ConfirmEscapeWithText: boolPtr(true)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Fatal("ctrl+c with an empty entry did not quit")
+	}
+
+	for _, r := range "draft" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd != nil {
+		t.Fatal("first ctrl+c quit with an unsaved entry")
+	}
+	if !m.quitConfirmActive || !strings.Contains(m.status, "ctrl+c again") {
+		t.Fatalf("no quit warning: active=%v status=%q", m.quitConfirmActive, m.status)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	if m.quitConfirmActive {
+		t.Fatal("typing did not reset the quit warning")
+	}
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd != nil {
+		t.Fatal("ctrl+c after typing quit without warning again")
+	}
+	if cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Fatal("second ctrl+c did not quit")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if !m.detail.editing || !strings.HasSuffix(m.detail.input.Value(), "q") {
+		t.Fatalf("q was not typed into the entry: %q", m.detail.input.Value())
+	}
+
+	list := newTestModel(t, app.Config{}, app.DayLog{})
+	if cmd := list.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}); cmd == nil {
+		t.Fatal("q did not quit from the list")
+	}
+}
+
+func TestEscapeConfirmWithText(t *testing.T) {
+	m := newTestModel(t, app.Config{ConfirmEscapeWithText: boolPtr(true), EscapeConfirmTimeoutMs: intPtr(500)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	typeText(m, "draft")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.detail.editing || m.detail.input.Value() != "draft" {
+		t.Fatalf("first esc discarded the entry: editing=%v input=%q", m.detail.editing, m.detail.input.Value())
+	}
+	if !m.escapeConfirmActive || !strings.Contains(m.escapeConfirmPrompt, "500ms") {
+		t.Fatalf("no discard prompt: %q", m.escapeConfirmPrompt)
+	}
+	if cmd == nil {
+		t.Fatal("no timeout scheduled for the discard prompt")
+	}
+
+	// The timeout of an earlier prompt must not clear a newer one.
+	m.Update(escapeConfirmTimeoutMsg{seq: m.escapeConfirmSeq - 1})
+	if !m.escapeConfirmActive {
+		t.Fatal("stale timeout cleared the prompt")
+	}
+	m.Update(escapeConfirmTimeoutMsg{seq: m.escapeConfirmSeq})
+	if m.escapeConfirmActive {
+		t.Fatal("prompt still active after the timeout")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.detail.editing || m.detail.input.Value() != "draft" {
+		t.Fatal("esc after the timeout discarded the entry without asking again")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.detail.editing || m.detail.input.Value() != "" {
+		t.Fatalf("double esc kept the entry: editing=%v input=%q", m.detail.editing, m.detail.input.Value())
+	}
+	if m.view != viewDetail {
+		t.Fatal("discarding the entry left the detail view")
+	}
+}
+
+func TestEscapeWithoutConfirm(t *testing.T) {
+	m := newTestModel(t, app.Config{ConfirmEscapeWithText: boolPtr(false)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	typeText(m, "draft")
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.detail.editing || m.escapeConfirmActive {
+		t.Fatal("esc asked for confirmation with confirmEscapeWithText off")
+	}
+
+	m = newTestModel(t, app.Config{ConfirmEscapeWithText: boolPtr(true)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.detail.editing || m.escapeConfirmActive {
+		t.Fatal("esc asked for confirmation with an empty entry")
+	}
+}
+
+func TestContinueInsertAfterSave(t *testing.T) {
+	m := newTestModel(t, app.Config{ContinueInsertAfterSave: boolPtr(true)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	typeText(m, "first")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.detail.editing || m.detail.input.Value() != "" {
+		t.Fatalf("after save: editing=%v input=%q, want editing with a cleared input", m.detail.editing, m.detail.input.Value())
+	}
+	typeText(m, "second")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := len(reloadDay(t).Answers["Q1"]); got != 2 {
+		t.Fatalf("saved %d entries, want 2", got)
+	}
+
+	m = newTestModel(t, app.Config{ContinueInsertAfterSave: boolPtr(false)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	typeText(m, "only")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.detail.editing || m.view != viewDetail {
+		t.Fatalf("after save: editing=%v view=%v, want the detail view", m.detail.editing, m.view)
+	}
+	if got := reloadDay(t).Answers["Q1"]; len(got) != 1 || got[0].Response != "only" {
+		t.Fatalf("saved answers = %+v", got)
+	}
+}
+
+func TestDetailIndexJump(t *testing.T) {
+	var questions []string
+	for i := 0; i < 20; i++ {
+		questions = append(questions, fmt.Sprintf("Q%d", i))
+	}
+	cfg := app.Config{Questions: questions, AutoOpenIndexJump: boolPtr(true), AutoInsertEntries: boolPtr(false)}
+	m := newTestModel(t, cfg, app.DayLog{})
+	m.openDetail("Q0", false)
+
+	for _, tt := range []struct {
+		key  rune
+		want string
+	}{{'3', "Q3"}, {'1', "Q1"}, {'b', "Q11"}, {'J', "Q19"}} {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{tt.key}})
+		if m.view != viewDetail || m.detail.question != tt.want {
+			t.Fatalf("%q opened %q, want %q", tt.key, m.detail.question, tt.want)
+		}
+	}
+
+	// e and i keep their detail-view meaning even where they are index labels.
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}}); cmd == nil || m.detail.question != "Q19" {
+		t.Fatalf("e jumped to %q instead of opening the editor", m.detail.question)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	if !m.detail.editing || m.detail.question != "Q19" {
+		t.Fatalf("i jumped to %q instead of starting an entry", m.detail.question)
+	}
+	typeText(m, "2")
+	if m.detail.question != "Q19" || m.detail.input.Value() != "2" {
+		t.Fatalf("index key jumped while editing: question=%q input=%q", m.detail.question, m.detail.input.Value())
+	}
+
+	cfg.AutoOpenIndexJump = boolPtr(false)
+	m = newTestModel(t, cfg, app.DayLog{})
+	m.openDetail("Q0", false)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}})
+	if m.detail.question != "Q0" {
+		t.Fatalf("jumped to %q with autoOpenIndexJump off", m.detail.question)
+	}
+}
+
+func TestDedupeEntriesInEditor(t *testing.T) {
+	for _, dedupe := range []bool{true, false} {
+		m := newTestModel(t, app.Config{DedupeEntries: boolPtr(dedupe)}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("same")}})
+		m.openDetail("Q1", true)
+		typeText(m, " same")
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		want := 2
+		if dedupe {
+			want = 1
+			if m.status != "Duplicate skipped." {
+				t.Errorf("status = %q", m.status)
+			}
+		}
+		if got := len(reloadDay(t).Answers["Q1"]); got != want {
+			t.Errorf("dedupeEntries=%v: %d answers, want %d", dedupe, got, want)
+		}
+	}
+}
+
+func TestReadOnlyBlocksMutatingKeys(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("keep")}})
+	m.readOnly = true
+	before := reloadDay(t)
+
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune{'i'}},
+		{Type: tea.KeyRunes, Runes: []rune{'e'}},
+		{Type: tea.KeyRunes, Runes: []rune{'d'}},
+		{Type: tea.KeyRunes, Runes: []rune{'o'}},
+		{Type: tea.KeyRunes, Runes: []rune{'p'}},
+		{Type: tea.KeyRunes, Runes: []rune{'m'}},
+	} {
+		m.status = ""
+		if cmd := m.handleKey(key); cmd != nil {
+			t.Errorf("%q returned a command in read-only mode", key.String())
+		}
+		if m.view != viewList || m.deleteConfirm != nil || m.moodPromptActive {
+			t.Fatalf("%q changed state in read-only mode", key.String())
+		}
+		if !strings.Contains(m.status, "Read-only") {
+			t.Errorf("%q status = %q", key.String(), m.status)
+		}
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.view != viewDetail || m.detail.editing {
+		t.Fatalf("enter: view=%v editing=%v, want the detail view without editing", m.view, m.detail.editing)
+	}
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyEnter}, {Type: tea.KeyRunes, Runes: []rune{'i'}}, {Type: tea.KeyRunes, Runes: []rune{'e'}}, {Type: tea.KeyCtrlO}} {
+		if cmd := m.handleKey(key); cmd != nil || m.detail.editing {
+			t.Errorf("%q in the detail view edited in read-only mode", key.String())
+		}
+	}
+
+	if !reflect.DeepEqual(reloadDay(t), before) {
+		t.Fatal("day file changed in read-only mode")
+	}
+	if !strings.Contains(m.View(), "[read-only]") {
+		t.Fatal("no read-only indicator")
+	}
+}
+
+func TestListScrollKeepsSelectionVisible(t *testing.T) {
+	var questions []string
+	for i := 0; i < 30; i++ {
+		questions = append(questions, fmt.Sprintf("Question %02d", i))
+	}
+	m := newTestModel(t, app.Config{Questions: questions, ShowHints: boolPtr(false), AutoOpenIndexJump: boolPtr(false)}, app.DayLog{})
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	// At the top only the past-day banner and the "↓ N more" line are shown.
+	capacity := m.listCapacity()
+	if capacity != 13 {
+		t.Fatalf("capacity = %d, want 13", capacity)
+	}
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	for i := 0; i < capacity-1; i++ {
+		m.Update(down)
+	}
+	if m.scrollOffset != 0 {
+		t.Fatalf("offset = %d with the selection on the last visible row", m.scrollOffset)
+	}
+	// Scrolling adds the "↑ N more" line, so one more row scrolls by two.
+	m.Update(down)
+	if m.selected != capacity || m.scrollOffset != 2 {
+		t.Fatalf("selected %d offset %d, want %d and 2", m.selected, m.scrollOffset, capacity)
+	}
+	for i := 0; i < 50; i++ {
+		m.Update(down)
+	}
+	if m.selected != len(questions)-1 || m.scrollOffset != len(questions)-capacity {
+		t.Fatalf("selected %d offset %d at the bottom", m.selected, m.scrollOffset)
+	}
+	view := m.View()
+	if !strings.Contains(view, "Question 29") || strings.Contains(view, "Question 16") || !strings.Contains(view, "↑ 17 more") {
+		t.Fatalf("bottom of the list not rendered:\n%s", view)
+	}
+
+	// Jumping by index label scrolls the jumped-to row into view.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	if m.selected != 2 || m.scrollOffset != 2 {
+		t.Fatalf("selected %d offset %d after jumping to 2", m.selected, m.scrollOffset)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if m.selected != 0 || m.scrollOffset != 0 {
+		t.Fatalf("selected %d offset %d back at the top", m.selected, m.scrollOffset)
+	}
+}
+
+func TestListFitsHeightOffToday(t *testing.T) {
+	var questions []string
+	for i := 0; i < 30; i++ {
+		questions = append(questions, fmt.Sprintf("Question %02d", i))
+	}
+	m := newTestModel(t, app.Config{Questions: questions, ShowHints: boolPtr(false), AutoOpenIndexJump: boolPtr(false)}, app.DayLog{})
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	m.changeDay(-1)
+	if m.dayBanner() == "" || m.status == "" {
+		t.Fatal("expected a past-day banner and a status line")
+	}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	for i := 0; i < len(questions); i++ {
+		if lines := strings.Count(m.View(), "\n"); lines > m.height {
+			t.Fatalf("view has %d lines at height %d with row %d selected:\n%s", lines, m.height, m.selected, m.View())
+		}
+		m.Update(down)
+	}
+}
+
+func TestCountEntryText(t *testing.T) {
+	tests := []struct {
+		value        string
+		chars, words int
+	}{
+		{"", 0, 0},
+		{"   \t\n ", 0, 0},
+		{"fixed the bug", 13, 3},
+		{"  padded  words  ", 13, 2},
+		{"café naïve", 10, 2},
+		{"日本語 テキスト", 8, 2},
+		{"ship it 🚀", 9, 3},
+	}
+	for _, tt := range tests {
+		chars, words := countEntryText(tt.value)
+		if chars != tt.chars || words != tt.words {
+			t.Errorf("countEntryText(%q) = %d, %d; want %d, %d", tt.value, chars, words, tt.chars, tt.words)
+		}
+	}
+}
+
+func TestEntryCountLabelSoftLimit(t *testing.T) {
+	m := newTestModel(t, app.Config{EntrySoftLimitChars: intPtr(5)}, app.DayLog{})
+	m.openDetail("Q1", true)
+	typeText(m, "short")
+	if got := m.entryCountLabel(); got != "5 chars • 1 words" {
+		t.Fatalf("label = %q", got)
+	}
+	typeText(m, "er")
+	if got := m.entryCountLabel(); !strings.HasSuffix(got, "over soft limit of 5") {
+		t.Fatalf("label = %q, want the soft limit warning", got)
+	}
+}
+
+func TestPriorityLine(t *testing.T) {
+	orig := lipgloss.ColorProfile()
+	t.Cleanup(func() { lipgloss.SetColorProfile(orig) })
+
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	high := priorityLine(app.Answer{Priority: app.PriorityHigh}, "urgent")
+	if !strings.Contains(high, "\x1b[") || !strings.Contains(high, "urgent") {
+		t.Errorf("high priority line = %q, want it colored", high)
+	}
+	if low := priorityLine(app.Answer{Priority: app.PriorityLow}, "later"); low == "later" || !strings.Contains(low, "later") {
+		t.Errorf("low priority line = %q, want it dimmed", low)
+	}
+	if normal := priorityLine(app.Answer{}, "regular"); normal != "regular" {
+		t.Errorf("normal priority line = %q, want it plain", normal)
+	}
+
+	lipgloss.SetColorProfile(termenv.Ascii)
+	if high := priorityLine(app.Answer{Priority: app.PriorityHigh}, "urgent"); high != "urgent" {
+		t.Errorf("high priority line without colors = %q, want it plain", high)
+	}
+}
+
+func TestViewTooSmall(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	guard := fmt.Sprintf("Terminal too small (need at least %dx%d)\n", minTerminalWidth, minTerminalHeight)
+	tests := []struct {
+		width, height int
+		small         bool
+	}{
+		{0, 0, false},
+		{minTerminalWidth, minTerminalHeight, false},
+		{minTerminalWidth - 1, minTerminalHeight, true},
+		{minTerminalWidth, minTerminalHeight - 1, true},
+		{120, 40, false},
+	}
+	for _, tt := range tests {
+		m.Update(tea.WindowSizeMsg{Width: tt.width, Height: tt.height})
+		view := m.View()
+		if (view == guard) != tt.small {
+			t.Errorf("%dx%d: view = %q, want guard %v", tt.width, tt.height, view, tt.small)
+		}
+	}
+}
+
+func TestExpandThenDelete(t *testing.T) {
+	cfg := app.Config{DefaultListMode: boolPtr(false), ConfirmDelete: boolPtr(true), SoftDelete: boolPtr(false)}
+	m := newTestModel(t, cfg, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("keep", "drop")}})
+	key := func(r rune) { m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}) }
+
+	key('d')
+	if m.deleteConfirm != nil || !strings.Contains(m.status, "x to expand") {
+		t.Fatalf("d on a collapsed question: status %q", m.status)
+	}
+
+	key('x')
+	if m.expandedQuestion != "Q1" {
+		t.Fatalf("x did not expand Q1: %q", m.expandedQuestion)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if row := m.currentRow(); row == nil || row.kind != rowEntry || row.entryIndex != 1 {
+		t.Fatalf("selected row = %+v, want the second entry", row)
+	}
+	key('d')
+	if m.deleteConfirm == nil {
+		t.Fatal("no delete confirmation")
+	}
+	key('y')
+	got := reloadDay(t).Answers["Q1"]
+	if len(got) != 1 || got[0].Response != "keep" {
+		t.Fatalf("answers after delete = %+v", got)
+	}
+	if m.listMode {
+		t.Fatal("expanding switched on list mode")
+	}
+
+	m.selectQuestionByName("Q1")
+	key('x')
+	if m.expandedQuestion != "" {
+		t.Fatalf("second x did not collapse: %q", m.expandedQuestion)
+	}
+	for _, row := range m.rows {
+		if row.kind == rowEntry {
+			t.Fatalf("entry rows left after collapsing: %+v", m.rows)
+		}
+	}
+}
+
+func TestTogglePin(t *testing.T) {
+	cfg := app.Config{DefaultListMode: boolPtr(false)}
+	m := newTestModel(t, cfg, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("first", "second", "third")}})
+	key := func(r rune) { m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}) }
+
+	key('x')
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	key('p')
+	saved := reloadDay(t).Answers["Q1"]
+	if !saved[2].Pinned || saved[0].Pinned || saved[1].Pinned {
+		t.Fatalf("pin not saved on the third entry: %+v", saved)
+	}
+	if row := m.currentRow(); row == nil || row.entryIndex != 2 {
+		t.Fatalf("selection did not follow the pinned entry: %+v", row)
+	}
+	var order []int
+	for _, row := range m.rows {
+		if row.kind == rowEntry {
+			order = append(order, row.entryIndex)
+		}
+	}
+	if !reflect.DeepEqual(order, []int{2, 0, 1}) {
+		t.Fatalf("entry rows = %v, want the pinned entry first", order)
+	}
+	if view := m.View(); !strings.Contains(view, "* [11:00] third") {
+		t.Fatalf("pinned entry not marked:\n%s", view)
+	}
+
+	key('p')
+	if reloadDay(t).Answers["Q1"][2].Pinned {
+		t.Fatal("second p did not unpin")
+	}
+}
+
+func TestDayBannerOnlyOffToday(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{})
+	if view := m.View(); !strings.Contains(view, "⚠ Logging to a PAST day") {
+		t.Fatalf("no past-day banner:\n%s", view)
+	}
+	m.readOnly = true
+	if got := m.dayBanner(); got != "⚠ Viewing a PAST day" {
+		t.Fatalf("read-only banner = %q", got)
+	}
+	m.readOnly = false
+
+	today := app.DayFloor(time.Now())
+	m.day = today.AddDate(0, 0, 1)
+	if got := m.dayBanner(); got != "⚠ Logging to a FUTURE day" {
+		t.Fatalf("future banner = %q", got)
+	}
+	m.day = today
+	if view := m.View(); strings.Contains(view, "⚠") {
+		t.Fatalf("banner shown for today:\n%s", view)
+	}
+}
+
+func TestBottomPadding(t *testing.T) {
+	tests := []struct {
+		content, footer string
+		height, want    int
+	}{
+		{"a\nb", "\nstatus", 10, 6},
+		{"a\nb", "\nstatus", 4, 0},
+		{"a\nb", "\nstatus", 3, 0},
+		{"a\nb\nc\nd", "\nstatus\nbar", 12, 5},
+		{"a", "\nstatus", 0, 0},
+	}
+	for _, tt := range tests {
+		if got := bottomPadding(tt.content, tt.footer, tt.height); got != tt.want {
+			t.Errorf("bottomPadding(%q, %q, %d) = %d, want %d", tt.content, tt.footer, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestStatusPositionBottom(t *testing.T) {
+	for _, position := range []string{app.StatusPositionInline, app.StatusPositionBottom} {
+		m := newTestModel(t, app.Config{StatusPosition: position}, app.DayLog{})
+		m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+		view := m.View()
+		lines := strings.Split(strings.TrimSuffix(view, "\n"), "\n")
+		if last := lines[len(lines)-1]; !strings.Contains(last, m.statusBar()) {
+			t.Fatalf("%s: last line = %q, want the status bar", position, last)
+		}
+		// The trailing newline takes the last terminal row.
+		if bottom := len(lines) == 39; bottom != (position == app.StatusPositionBottom) {
+			t.Fatalf("%s: view has %d lines at height 40", position, len(lines))
+		}
+	}
+}
+
+func TestDeleteEntrySoftDeletes(t *testing.T) {
+	cfg := app.Config{DefaultListMode: boolPtr(false), ConfirmDelete: boolPtr(false), SoftDelete: boolPtr(true)}
+	m := newTestModel(t, cfg, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("keep", "drop")}})
+	key := func(r rune) { m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}) }
+	key('x')
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	key('d')
+
+	log := reloadDay(t)
+	if len(log.Answers["Q1"]) != 1 || log.Answers["Q1"][0].Response != "keep" {
+		t.Fatalf("answers after delete = %+v", log.Answers)
+	}
+	if len(log.Trash) != 1 || log.Trash[0].Response != "drop" || log.Trash[0].Question != "Q1" {
+		t.Fatalf("trash = %+v, want the deleted entry", log.Trash)
+	}
+	if strings.Contains(m.View(), "drop") {
+		t.Fatal("TUI still shows the trashed entry")
+	}
+}
+
+func TestSaveToFutureDay(t *testing.T) {
+	tomorrow := app.DayFloor(time.Now()).AddDate(0, 0, 1)
+	for _, allow := range []bool{false, true} {
+		cfg := app.Config{Questions: []string{"Q1"}, AllowFutureEntries: boolPtr(allow)}
+		newTestModel(t, cfg, app.DayLog{})
+		m, err := newModel(cfg, Options{Date: tomorrow})
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.openDetail("Q1", true)
+		typeText(m, "planned")
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		log, err := app.LoadDayLog(tomorrow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved := len(log.Answers["Q1"]) == 1
+		if saved != allow {
+			t.Fatalf("allowFutureEntries=%v: saved=%v", allow, saved)
+		}
+		if !allow && !strings.Contains(m.status, "allowFutureEntries") {
+			t.Fatalf("refusal status = %q", m.status)
+		}
+	}
+}
+
+func TestQuestionEditOnFutureDay(t *testing.T) {
+	tomorrow := app.DayFloor(time.Now()).AddDate(0, 0, 1)
+	cfg := app.Config{Questions: []string{"Q1"}}
+	newTestModel(t, cfg, app.DayLog{})
+	if err := app.SaveDayLog(tomorrow, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("old", "keep")}}, cfg.Settings()); err != nil {
+		t.Fatal(err)
+	}
+	m, err := newModel(cfg, Options{Date: tomorrow})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := func() []string {
+		t.Helper()
+		log, err := app.LoadDayLog(tomorrow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, ans := range log.Answers["Q1"] {
+			got = append(got, ans.Response)
+		}
+		return got
+	}
+
+	// Replacing a response keeps the count but still creates an entry.
+	m.applyQuestionEdit("Q1", []string{"new", "keep"})
+	if got := stored(); !reflect.DeepEqual(got, []string{"old", "keep"}) || !strings.Contains(m.status, "allowFutureEntries") {
+		t.Fatalf("replacement on a future day: stored %v, status %q", got, m.status)
+	}
+
+	// Dropping a response creates nothing, so it is allowed.
+	m.applyQuestionEdit("Q1", []string{"keep"})
+	if got := stored(); !reflect.DeepEqual(got, []string{"keep"}) {
+		t.Fatalf("removal on a future day: stored %v, status %q", got, m.status)
+	}
+}
+
+func TestNewModelOpensGivenDay(t *testing.T) {
+	m := newTestModel(t, app.Config{}, app.DayLog{Answers: map[string][]app.Answer{"Q1": answers("on the day")}})
+	if !m.day.Equal(testDay) || len(m.log.Answers["Q1"]) != 1 {
+		t.Fatalf("model opened %s with %+v, want %s", m.day, m.log.Answers, testDay)
+	}
+
+	m, err := newModel(app.Config{Questions: []string{"Q1"}}, Options{Date: testDay.Add(15*time.Hour + 30*time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.day.Equal(testDay) || len(m.log.Answers["Q1"]) != 1 {
+		t.Fatalf("a date with a clock did not open the whole day: %s", m.day)
+	}
+
+	m, err = newModel(app.Config{Questions: []string{"Q1"}}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.day.Equal(app.DayFloor(time.Now())) || len(m.log.Answers) != 0 {
+		t.Fatalf("no date opened %s with %+v, want today", m.day, m.log.Answers)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/almahoozi/wlog/internal/app"
 	"github.com/almahoozi/wlog/internal/tuiapp"
@@ -16,8 +17,16 @@ var (
 )
 
 func main() {
-	args := os.Args[1:]
 	info := app.BuildInfo{Commit: commit, Ref: ref, Version: version}
+	globals, args, err := app.ParseGlobalFlags(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	tuiapp.SetEditor(globals.Editor)
+	app.ConfigEditor = tuiapp.RunConfigEditor
+	app.SetQuiet(globals.Quiet)
+	app.SetConfigPath(globals.Config)
 
 	if len(args) == 0 {
 		runTUI()
@@ -25,29 +34,77 @@ func main() {
 	}
 
 	switch args[0] {
+	case "tui":
+		runTUIWithArgs(args[1:])
 	case "config":
+		if len(args) > 1 && args[1] == "clean" {
+			runCLI(info)
+			return
+		}
+		if len(args) > 1 && args[1] != "edit" {
+			fmt.Fprintf(os.Stderr, "unknown config argument %q\n", args[1])
+			os.Exit(1)
+		}
 		runConfigTUI()
 	case "help", "-h", "--help":
 		printTUIHelp()
 	default:
-		if err := app.Run(args, info); err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(1)
-		}
+		runCLI(info)
+	}
+}
+
+func runCLI(info app.BuildInfo) {
+	if err := app.Run(os.Args[1:], info); err != nil {
+		os.Exit(app.ReportError(err))
 	}
 }
 
 func runTUI() {
 	if err := tuiapp.Run(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(app.ReportError(err))
+	}
+}
+
+func runTUIWithArgs(args []string) {
+	if len(args) > 0 && args[0] == "config" {
+		runConfigTUI()
+		return
+	}
+	var opts tuiapp.Options
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+		switch name {
+		case "--read-only":
+			opts.ReadOnly = true
+		case "--date":
+			if !hasValue {
+				if i+1 >= len(args) {
+					fmt.Fprintln(os.Stderr, "missing value for --date")
+					os.Exit(1)
+				}
+				i++
+				value = args[i]
+			}
+			day, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(value), time.Local)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid --date %q, expected YYYY-MM-DD\n", value)
+				os.Exit(1)
+			}
+			opts.Date = day
+		default:
+			fmt.Fprintf(os.Stderr, "unknown tui argument %q\n", arg)
+			os.Exit(1)
+		}
+	}
+	if err := tuiapp.RunWithOptions(opts); err != nil {
+		os.Exit(app.ReportError(err))
 	}
 }
 
 func runConfigTUI() {
 	if err := tuiapp.RunConfigEditor(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(app.ReportError(err))
 	}
 }
 
@@ -56,12 +113,83 @@ func printTUIHelp() {
 
 Usage:
   wlog                 Launch the TUI
-  wlog config          Configure wlog via the TUI
-  wlog version         Show build metadata
+  wlog tui --read-only Browse logs in the TUI without editing
+  wlog tui --date YYYY-MM-DD
+                       Open the TUI on a given day instead of today
+  wlog config [edit]   Configure wlog via the TUI (also: wlog tui config)
+  wlog config clean    Remove unknown _-prefixed markers from the config file
+  wlog version [--json]
+                       Show build metadata (as JSON with --json)
   wlog ls              Print the log storage directory path
   wlog ls config       Print the config file path
   wlog cat [interval]  Print the list view for today or a plain-english period
+  wlog cat --plain     Print the list view without relative labels or counts
+  wlog cat --no-empty-questions
+                       Leave out questions without answers that day
+  wlog cat --include-archived [interval]
+                       Also read days moved to archive/ (also for view and grep)
+  wlog add [--priority high|low] [--date DATE] [--time HH:MM] <question> [text]
+                       Add an entry to today's log; reads stdin when text is omitted
+                       (with only the text, pick the question from a numbered list)
+  wlog add --question-text <question> [text]
+                       Log against a question that is not in the config
+  wlog --set <question>=<text> [--set ...]
+                       Add answers to several questions in one command
+  wlog mood <1-5> [date]
+                       Rate a day from 1 to 5 (default today)
+  wlog mood --trend [interval]
+                       Show a mood sparkline and average for an interval
+  wlog carry [date]    Copy yesterday's answers to the carryQuestions into today
+  wlog trash [restore] [date]
+                       List or restore entries deleted with softDelete on
+  wlog remind [--require <question>]...
+                       Exit 2 if nothing, or a required question, is logged today
+  wlog rename-question <old> <new>
+                       Move answers to a reworded question in every day file
+  wlog rm [--force] <date|interval>
+                       Remove the day files for a date or interval after confirming
+  wlog prune --empty [--force]
+                       Remove day files without any entries
+  wlog archive --older-than <N days>
+                       Move old day files into the archive/ subdirectory
+  wlog backup [dest]   Zip the log directory and config file into dest
+  wlog restore [--force] <zip>
+                       Restore logs and config from a backup zip
+  wlog commit [message] Commit log changes when the data directory is a git repo
+  wlog questions       List configured questions with their 1-based index and TUI label
+  wlog info            Show storage paths, logged date range, and entry totals
+  wlog open [config]   Open the storage directory or reveal the config file
+  wlog grep [--strict] [--include-archived] [--context] <term>
+                       Search day files and configured questions for a term
+                       (--context also lists the question's other answers that day)
+  wlog last [--strict] [n]
+                       Show the n most recent entries across all days
+  wlog words [--top N] [interval]
+                       Show the most frequent words in responses
+  wlog dupes [interval]
+                       List responses logged more than once per question
+  wlog stats [--ndays-active] [interval]
+                       Show entry totals per question and logging consistency
+  wlog export by-question [interval]
+                       Print every answer in the interval grouped by question
+  wlog export form [date]
+                       Print a blank fill-in form of the configured questions for a day
+  wlog import form [file]
+                       Add the answers from a filled-in form (stdin when file is omitted)
+  wlog digest [interval]
+                       Print one summary line per day with entries
+  wlog schema day|config
+                       Print the JSON Schema of day files or the config file
+  wlog completion <sh> Print a completion script for bash, zsh, or fish
   wlog help            Show this help message
 
+Global flags (before the command):
+  --editor <command>   Editor to launch instead of $VISUAL/$EDITOR
+  --dry-run            Print the changes a command would make without writing them
+  --quiet              Suppress non-fatal warnings (or set WLOG_QUIET=1)
+  --config <path>      Use this config file (.yaml/.yml files are read as YAML)
+
+Exit codes: 0 success, 1 error, 2 view/cat/remind found no entries, 3 config error.
+
 Tip: Press h in the TUI to toggle on-screen hints.`))
 }